@@ -0,0 +1,396 @@
+package ecl
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v3"
+)
+
+// Power operations accepted by the baremetal server power API.
+const (
+	baremetalServerV2PowerOn         = "ON"
+	baremetalServerV2PowerOff        = "OFF"
+	baremetalServerV2PowerSoftReboot = "SOFT_REBOOT"
+	baremetalServerV2PowerHardReboot = "HARD_REBOOT"
+)
+
+// BaremetalServerMetadataOpts represents the metadata of a baremetal server
+// to be set by an update request.
+type BaremetalServerMetadataOpts map[string]string
+
+// ToBaremetalServerMetadataMap casts a BaremetalServerMetadataOpts to a map.
+func (opts BaremetalServerMetadataOpts) ToBaremetalServerMetadataMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"metadata": opts}, nil
+}
+
+// BaremetalServerPowerOpts represents the attributes used when changing
+// the power state of a baremetal server.
+type BaremetalServerPowerOpts struct {
+	// Power is the power operation to be performed.
+	// One of ON, OFF, SOFT_REBOOT or HARD_REBOOT.
+	Power string `json:"power" required:"true"`
+}
+
+// ToBaremetalServerPowerMap casts a BaremetalServerPowerOpts struct to a map.
+func (opts BaremetalServerPowerOpts) ToBaremetalServerPowerMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "")
+}
+
+func baremetalServerV2MetadataURL(client *eclcloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "metadata")
+}
+
+func baremetalServerV2PowerURL(client *eclcloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "power")
+}
+
+// baremetalServerV2ResetMetadata replaces all metadata of the baremetal server
+// with the given key-value pairs.
+func baremetalServerV2ResetMetadata(client *eclcloud.ServiceClient, id string, opts BaremetalServerMetadataOpts) (r eclcloud.Result) {
+	b, err := opts.ToBaremetalServerMetadataMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(baremetalServerV2MetadataURL(client, id), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// baremetalServerV2UpdatePower requests a power operation on the baremetal server.
+func baremetalServerV2UpdatePower(client *eclcloud.ServiceClient, id string, opts BaremetalServerPowerOpts) (r eclcloud.ErrResult) {
+	b, err := opts.ToBaremetalServerPowerMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(baremetalServerV2PowerURL(client, id), b, nil, &eclcloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// baremetalServerV2PowerState converts the OS-EXT-STS:power_state of
+// a baremetal server into the value of the power_state attribute.
+func baremetalServerV2PowerState(powerState string) string {
+	switch strings.ToUpper(powerState) {
+	case "RUNNING", "ON":
+		return "on"
+	case "SHUTDOWN", "SHUTOFF", "OFF":
+		return "off"
+	}
+	return strings.ToLower(powerState)
+}
+
+// baremetalServerV2RaidDisks describes the number of disks each supported
+// RAID level accepts.
+var baremetalServerV2RaidDisks = map[int]struct {
+	min  int
+	even bool
+}{
+	0:  {min: 2},
+	1:  {min: 2, even: true},
+	5:  {min: 3},
+	6:  {min: 4},
+	10: {min: 4, even: true},
+}
+
+// parseBaremetalServerV2Size converts a size such as "100G" into gigabytes.
+func parseBaremetalServerV2Size(size string) (float64, error) {
+	s := strings.ToUpper(strings.TrimSpace(size))
+	if s == "" {
+		return 0, fmt.Errorf("size must not be empty")
+	}
+
+	unit := 1.0
+	switch s[len(s)-1] {
+	case 'M':
+		unit = 1.0 / 1024
+		s = s[:len(s)-1]
+	case 'G':
+		s = s[:len(s)-1]
+	case 'T':
+		unit = 1024
+		s = s[:len(s)-1]
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("invalid size %q: must be a positive number with an optional M, G or T suffix", size)
+	}
+
+	return v * unit, nil
+}
+
+type baremetalServerV2Partition struct {
+	lvm bool
+	// size is the size of the partition in gigabytes.
+	// It is negative when the size is unknown before provisioning.
+	size float64
+}
+
+// validateBaremetalServerV2Layout cross-validates the raid_arrays,
+// lvm_volume_groups, filesystems and personality of a baremetal server.
+// flavorDisk is the disk size of the flavor in gigabytes, or 0 if unknown.
+func validateBaremetalServerV2Layout(raidArrays, lvmVolumeGroups, filesystems, personality []interface{}, flavorDisk int) error {
+	var errs []string
+	addErr := func(format string, a ...interface{}) {
+		errs = append(errs, fmt.Sprintf(format, a...))
+	}
+
+	partitions := make(map[string]*baremetalServerV2Partition)
+	disks := make(map[string]int)
+	primaryCount := 0
+
+	for i, v := range raidArrays {
+		raidArray, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		primary := raidArray["primary_storage"].(bool)
+		raidCard := raidArray["raid_card_hardware_id"].(string)
+		diskIDs := raidArray["disk_hardware_ids"].([]interface{})
+		raidLevel := raidArray["raid_level"].(int)
+
+		if primary {
+			primaryCount++
+		} else {
+			if raidCard == "" {
+				addErr("raid_arrays.%d: raid_card_hardware_id is required unless primary_storage is true", i)
+			}
+			if len(diskIDs) == 0 {
+				addErr("raid_arrays.%d: disk_hardware_ids is required unless primary_storage is true", i)
+			}
+		}
+
+		if len(diskIDs) > 0 {
+			rule, ok := baremetalServerV2RaidDisks[raidLevel]
+			switch {
+			case !ok:
+				addErr("raid_arrays.%d: unsupported raid_level %d, must be one of 0, 1, 5, 6 or 10", i, raidLevel)
+			case len(diskIDs) < rule.min:
+				addErr("raid_arrays.%d: raid_level %d requires at least %d disks, got %d", i, raidLevel, rule.min, len(diskIDs))
+			case rule.even && len(diskIDs)%2 != 0:
+				addErr("raid_arrays.%d: raid_level %d requires an even number of disks, got %d", i, raidLevel, len(diskIDs))
+			}
+		}
+
+		for _, d := range diskIDs {
+			id, _ := d.(string)
+			if j, ok := disks[id]; ok {
+				addErr("raid_arrays.%d: disk %q is already used by raid_arrays.%d", i, id, j)
+				continue
+			}
+			disks[id] = i
+		}
+
+		var used float64
+		var rest *baremetalServerV2Partition
+		for j, w := range raidArray["partitions"].([]interface{}) {
+			partition, ok := w.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			label := partition["partition_label"].(string)
+			size := partition["size"].(string)
+			if label == "" {
+				addErr("raid_arrays.%d.partitions.%d: partition_label is required", i, j)
+				continue
+			}
+			if _, ok := partitions[label]; ok {
+				addErr("raid_arrays.%d.partitions.%d: duplicate partition_label %q", i, j, label)
+				continue
+			}
+
+			p := &baremetalServerV2Partition{lvm: partition["lvm"].(bool), size: -1}
+			partitions[label] = p
+
+			if size == "" {
+				if rest != nil {
+					addErr("raid_arrays.%d.partitions.%d: size can be omitted for only one partition per raid array", i, j)
+				}
+				rest = p
+				continue
+			}
+
+			gb, err := parseBaremetalServerV2Size(size)
+			if err != nil {
+				addErr("raid_arrays.%d.partitions.%d: %s", i, j, err)
+				continue
+			}
+			p.size = gb
+			used += gb
+		}
+
+		if primary && flavorDisk > 0 {
+			if used > float64(flavorDisk) {
+				addErr("raid_arrays.%d: partitions require %.0fG but the flavor provides only %dG", i, used, flavorDisk)
+			} else if rest != nil {
+				// The partition without size takes the rest of the disk.
+				rest.size = float64(flavorDisk) - used
+			}
+		}
+	}
+
+	if primaryCount > 1 {
+		addErr("raid_arrays: only one raid array can have primary_storage set to true, got %d", primaryCount)
+	}
+
+	logicalVolumes := make(map[string]bool)
+	physicalVolumes := make(map[string]string)
+	vgLabels := make(map[string]bool)
+	for i, v := range lvmVolumeGroups {
+		vg, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		vgLabel := vg["vg_label"].(string)
+		if vgLabel == "" {
+			addErr("lvm_volume_groups.%d: vg_label is required", i)
+		} else if vgLabels[vgLabel] {
+			addErr("lvm_volume_groups.%d: duplicate vg_label %q", i, vgLabel)
+		}
+		vgLabels[vgLabel] = true
+
+		capacity := 0.0
+		for _, w := range vg["physical_volume_partition_labels"].([]interface{}) {
+			label, _ := w.(string)
+			if owner, ok := physicalVolumes[label]; ok {
+				addErr("lvm_volume_groups.%d: partition %q is already used by volume group %q", i, label, owner)
+				continue
+			}
+			physicalVolumes[label] = vgLabel
+
+			if len(raidArrays) == 0 {
+				capacity = -1
+				continue
+			}
+
+			p, ok := partitions[label]
+			if !ok {
+				addErr("lvm_volume_groups.%d: physical volume partition %q does not exist in raid_arrays", i, label)
+				capacity = -1
+				continue
+			}
+			if !p.lvm {
+				addErr("lvm_volume_groups.%d: partition %q must have lvm set to true to be used as a physical volume", i, label)
+			}
+			if p.size < 0 || capacity < 0 {
+				capacity = -1
+				continue
+			}
+			capacity += p.size
+		}
+
+		var used float64
+		omitted := false
+		for j, w := range vg["logical_volumes"].([]interface{}) {
+			lv, ok := w.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			lvLabel := lv["lv_label"].(string)
+			if lvLabel == "" {
+				addErr("lvm_volume_groups.%d.logical_volumes.%d: lv_label is required", i, j)
+				continue
+			}
+			if logicalVolumes[lvLabel] {
+				addErr("lvm_volume_groups.%d.logical_volumes.%d: duplicate lv_label %q", i, j, lvLabel)
+			}
+			logicalVolumes[lvLabel] = true
+
+			size := lv["size"].(string)
+			if size == "" {
+				if omitted {
+					addErr("lvm_volume_groups.%d.logical_volumes.%d: size can be omitted for only one logical volume per volume group", i, j)
+				}
+				omitted = true
+				continue
+			}
+
+			gb, err := parseBaremetalServerV2Size(size)
+			if err != nil {
+				addErr("lvm_volume_groups.%d.logical_volumes.%d: %s", i, j, err)
+				continue
+			}
+			used += gb
+		}
+
+		if capacity >= 0 && used > capacity {
+			addErr("lvm_volume_groups.%d: logical volumes require %.0fG but volume group %q provides only %.0fG", i, used, vgLabel, capacity)
+		}
+	}
+
+	mountPoints := make(map[string]int)
+	fsLabels := make(map[string]int)
+	for i, v := range filesystems {
+		fs, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		label := fs["label"].(string)
+		mountPoint := fs["mount_point"].(string)
+		fsType := fs["fs_type"].(string)
+
+		if label == "" {
+			addErr("filesystems.%d: label is required", i)
+		} else {
+			if j, ok := fsLabels[label]; ok {
+				addErr("filesystems.%d: label %q is already used by filesystems.%d", i, label, j)
+			}
+			fsLabels[label] = i
+
+			if !logicalVolumes[label] && len(raidArrays) > 0 {
+				p, ok := partitions[label]
+				switch {
+				case !ok:
+					addErr("filesystems.%d: label %q does not match any logical volume or partition", i, label)
+				case p.lvm:
+					addErr("filesystems.%d: partition %q is an LVM physical volume and cannot hold a filesystem", i, label)
+				}
+			}
+		}
+
+		if fsType == "swap" {
+			if mountPoint != "" {
+				addErr("filesystems.%d: mount_point must not be set for swap", i)
+			}
+			continue
+		}
+
+		if mountPoint == "" {
+			addErr("filesystems.%d: mount_point is required for fs_type %q", i, fsType)
+			continue
+		}
+		if !strings.HasPrefix(mountPoint, "/") {
+			addErr("filesystems.%d: mount_point %q must be an absolute path", i, mountPoint)
+		}
+		if j, ok := mountPoints[mountPoint]; ok {
+			addErr("filesystems.%d: mount_point %q is already used by filesystems.%d", i, mountPoint, j)
+		}
+		mountPoints[mountPoint] = i
+	}
+
+	for i, v := range personality {
+		p, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if path := p["path"].(string); !strings.HasPrefix(path, "/") {
+			addErr("personality.%d: path %q must be an absolute path", i, path)
+		}
+		if _, err := base64.StdEncoding.DecodeString(p["contents"].(string)); err != nil {
+			addErr("personality.%d: contents must be base64 encoded: %s", i, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid disk layout for baremetal server:\n  * %s", strings.Join(errs, "\n  * "))
+	}
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package ecl
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestParseBaremetalServerV2Size(t *testing.T) {
+	cases := []struct {
+		size     string
+		expected float64
+		valid    bool
+	}{
+		{size: "100G", expected: 100, valid: true},
+		{size: "2g", expected: 2, valid: true},
+		{size: "512M", expected: 0.5, valid: true},
+		{size: "1T", expected: 1024, valid: true},
+		{size: "50", expected: 50, valid: true},
+		{size: "", valid: false},
+		{size: "G", valid: false},
+		{size: "-1G", valid: false},
+		{size: "tenG", valid: false},
+	}
+
+	for _, c := range cases {
+		v, err := parseBaremetalServerV2Size(c.size)
+		if c.valid && err != nil {
+			t.Fatalf("expected %q to be valid, got %s", c.size, err)
+		}
+		if !c.valid && err == nil {
+			t.Fatalf("expected %q to be invalid", c.size)
+		}
+		if c.valid && v != c.expected {
+			t.Fatalf("expected %q to be %v, got %v", c.size, c.expected, v)
+		}
+	}
+}
+
+func testBaremetalServerV2RaidArray(primary bool, raidLevel int, disks []interface{}, partitions ...interface{}) interface{} {
+	raidCard := ""
+	if !primary {
+		raidCard = "raid_card_uuid"
+	}
+	return map[string]interface{}{
+		"primary_storage":       primary,
+		"raid_card_hardware_id": raidCard,
+		"disk_hardware_ids":     disks,
+		"raid_level":            raidLevel,
+		"partitions":            partitions,
+	}
+}
+
+func testBaremetalServerV2Partition(label, size string, lvm bool) interface{} {
+	return map[string]interface{}{
+		"partition_label": label,
+		"size":            size,
+		"lvm":             lvm,
+	}
+}
+
+func testBaremetalServerV2VolumeGroup(label string, pvs []interface{}, lvs ...interface{}) interface{} {
+	return map[string]interface{}{
+		"vg_label":                         label,
+		"physical_volume_partition_labels": pvs,
+		"logical_volumes":                  lvs,
+	}
+}
+
+func testBaremetalServerV2LogicalVolume(label, size string) interface{} {
+	return map[string]interface{}{
+		"lv_label": label,
+		"size":     size,
+	}
+}
+
+func testBaremetalServerV2Filesystem(label, mountPoint, fsType string) interface{} {
+	return map[string]interface{}{
+		"label":       label,
+		"mount_point": mountPoint,
+		"fs_type":     fsType,
+	}
+}
+
+func TestValidateBaremetalServerV2Layout(t *testing.T) {
+	validRaidArrays := []interface{}{
+		testBaremetalServerV2RaidArray(true, 0, nil,
+			testBaremetalServerV2Partition("primary-part1", "", true),
+			testBaremetalServerV2Partition("var", "100G", false),
+		),
+		testBaremetalServerV2RaidArray(false, 10, []interface{}{"d1", "d2", "d3", "d4"},
+			testBaremetalServerV2Partition("secondary-part1", "", true),
+		),
+	}
+	validVolumeGroups := []interface{}{
+		testBaremetalServerV2VolumeGroup("VG_root", []interface{}{"primary-part1", "secondary-part1"},
+			testBaremetalServerV2LogicalVolume("LV_root", "300G"),
+			testBaremetalServerV2LogicalVolume("LV_swap", "2G"),
+		),
+	}
+	validFilesystems := []interface{}{
+		testBaremetalServerV2Filesystem("LV_root", "/", "xfs"),
+		testBaremetalServerV2Filesystem("var", "/var", "xfs"),
+		testBaremetalServerV2Filesystem("LV_swap", "", "swap"),
+	}
+	validPersonality := []interface{}{
+		map[string]interface{}{"path": "/etc/motd", "contents": "aGVsbG8="},
+	}
+
+	cases := []struct {
+		raidArrays      []interface{}
+		lvmVolumeGroups []interface{}
+		filesystems     []interface{}
+		personality     []interface{}
+		flavorDisk      int
+		expectedErr     *regexp.Regexp
+	}{
+		{
+			raidArrays:      validRaidArrays,
+			lvmVolumeGroups: validVolumeGroups,
+			filesystems:     validFilesystems,
+			personality:     validPersonality,
+			flavorDisk:      500,
+		},
+		{
+			// Layout without raid_arrays relies on the default partitions.
+			filesystems: []interface{}{testBaremetalServerV2Filesystem("root", "/", "xfs")},
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(false, 5, []interface{}{"d1", "d2"}),
+			},
+			expectedErr: regexp.MustCompile(`raid_arrays\.0: raid_level 5 requires at least 3 disks, got 2`),
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(false, 1, []interface{}{"d1", "d2", "d3"}),
+			},
+			expectedErr: regexp.MustCompile(`raid_arrays\.0: raid_level 1 requires an even number of disks, got 3`),
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(false, 3, []interface{}{"d1", "d2", "d3"}),
+			},
+			expectedErr: regexp.MustCompile(`raid_arrays\.0: unsupported raid_level 3`),
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(false, 1, []interface{}{"d1", "d2"}),
+				testBaremetalServerV2RaidArray(false, 1, []interface{}{"d2", "d3"}),
+			},
+			expectedErr: regexp.MustCompile(`raid_arrays\.1: disk "d2" is already used by raid_arrays\.0`),
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(true, 0, nil),
+				testBaremetalServerV2RaidArray(true, 0, nil),
+			},
+			expectedErr: regexp.MustCompile(`only one raid array can have primary_storage set to true`),
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(true, 0, nil,
+					testBaremetalServerV2Partition("root", "400G", false),
+					testBaremetalServerV2Partition("var", "200G", false),
+				),
+			},
+			flavorDisk:  500,
+			expectedErr: regexp.MustCompile(`raid_arrays\.0: partitions require 600G but the flavor provides only 500G`),
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(true, 0, nil,
+					testBaremetalServerV2Partition("root", "", false),
+					testBaremetalServerV2Partition("var", "", false),
+				),
+			},
+			expectedErr: regexp.MustCompile(`raid_arrays\.0\.partitions\.1: size can be omitted for only one partition`),
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(true, 0, nil,
+					testBaremetalServerV2Partition("root", "10X", false),
+				),
+			},
+			expectedErr: regexp.MustCompile(`raid_arrays\.0\.partitions\.0: invalid size "10X"`),
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(true, 0, nil,
+					testBaremetalServerV2Partition("pv", "", true),
+				),
+			},
+			lvmVolumeGroups: []interface{}{
+				testBaremetalServerV2VolumeGroup("VG_root", []interface{}{"missing"}),
+			},
+			expectedErr: regexp.MustCompile(`lvm_volume_groups\.0: physical volume partition "missing" does not exist in raid_arrays`),
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(true, 0, nil,
+					testBaremetalServerV2Partition("pv", "", false),
+				),
+			},
+			lvmVolumeGroups: []interface{}{
+				testBaremetalServerV2VolumeGroup("VG_root", []interface{}{"pv"}),
+			},
+			expectedErr: regexp.MustCompile(`partition "pv" must have lvm set to true`),
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(true, 0, nil,
+					testBaremetalServerV2Partition("pv", "100G", true),
+				),
+			},
+			lvmVolumeGroups: []interface{}{
+				testBaremetalServerV2VolumeGroup("VG_root", []interface{}{"pv"},
+					testBaremetalServerV2LogicalVolume("LV_root", "80G"),
+					testBaremetalServerV2LogicalVolume("LV_var", "30G"),
+				),
+			},
+			expectedErr: regexp.MustCompile(`logical volumes require 110G but volume group "VG_root" provides only 100G`),
+		},
+		{
+			raidArrays: []interface{}{
+				testBaremetalServerV2RaidArray(true, 0, nil,
+					testBaremetalServerV2Partition("pv", "", true),
+				),
+			},
+			lvmVolumeGroups: []interface{}{
+				testBaremetalServerV2VolumeGroup("VG_root", []interface{}{"pv"},
+					testBaremetalServerV2LogicalVolume("LV_root", "400G"),
+					testBaremetalServerV2LogicalVolume("LV_var", "200G"),
+				),
+			},
+			flavorDisk:  500,
+			expectedErr: regexp.MustCompile(`logical volumes require 600G but volume group "VG_root" provides only 500G`),
+		},
+		{
+			raidArrays: validRaidArrays,
+			filesystems: []interface{}{
+				testBaremetalServerV2Filesystem("LV_missing", "/", "xfs"),
+			},
+			expectedErr: regexp.MustCompile(`filesystems\.0: label "LV_missing" does not match any logical volume or partition`),
+		},
+		{
+			raidArrays: validRaidArrays,
+			filesystems: []interface{}{
+				testBaremetalServerV2Filesystem("primary-part1", "/", "xfs"),
+			},
+			expectedErr: regexp.MustCompile(`filesystems\.0: partition "primary-part1" is an LVM physical volume`),
+		},
+		{
+			raidArrays:      validRaidArrays,
+			lvmVolumeGroups: validVolumeGroups,
+			filesystems: []interface{}{
+				testBaremetalServerV2Filesystem("LV_root", "/", "xfs"),
+				testBaremetalServerV2Filesystem("var", "/", "xfs"),
+			},
+			expectedErr: regexp.MustCompile(`filesystems\.1: mount_point "/" is already used by filesystems\.0`),
+		},
+		{
+			raidArrays:      validRaidArrays,
+			lvmVolumeGroups: validVolumeGroups,
+			filesystems: []interface{}{
+				testBaremetalServerV2Filesystem("LV_swap", "/swap", "swap"),
+			},
+			expectedErr: regexp.MustCompile(`filesystems\.0: mount_point must not be set for swap`),
+		},
+		{
+			personality: []interface{}{
+				map[string]interface{}{"path": "etc/motd", "contents": "not base64!"},
+			},
+			expectedErr: regexp.MustCompile(`(?s)personality\.0: path "etc/motd" must be an absolute path.*personality\.0: contents must be base64 encoded`),
+		},
+	}
+
+	for i, c := range cases {
+		err := validateBaremetalServerV2Layout(c.raidArrays, c.lvmVolumeGroups, c.filesystems, c.personality, c.flavorDisk)
+		if c.expectedErr == nil {
+			if err != nil {
+				t.Fatalf("expected test case %d to produce no errors, got %s", i, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got nil", i, c.expectedErr)
+		}
+
+		if !c.expectedErr.MatchString(err.Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %s", i, c.expectedErr, err)
+		}
+	}
+}
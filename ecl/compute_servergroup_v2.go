@@ -0,0 +1,128 @@
+package ecl
+
+import (
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/compute/v2/servers"
+)
+
+// ServerGroup represents a server group of the compute service.
+type ServerGroup struct {
+	// ID is the unique ID of the server group.
+	ID string `json:"id"`
+
+	// Name is the name of the server group.
+	Name string `json:"name"`
+
+	// Policies are the scheduling policies applied to the server group.
+	Policies []string `json:"policies"`
+
+	// Members are the IDs of the servers which belong to the server group.
+	Members []string `json:"members"`
+
+	// Metadata includes a list of all user-specified key-value pairs attached
+	// to the server group.
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+// ServerGroupCreateOpts represents the attributes used when creating a new server group.
+type ServerGroupCreateOpts struct {
+	// Name is the name of the server group.
+	Name string `json:"name" required:"true"`
+
+	// Policies are the scheduling policies of the server group.
+	Policies []string `json:"policies" required:"true"`
+}
+
+// ToServerGroupCreateMap casts a ServerGroupCreateOpts struct to a map.
+func (opts ServerGroupCreateOpts) ToServerGroupCreateMap() (map[string]interface{}, error) {
+	return eclcloud.BuildRequestBody(opts, "server_group")
+}
+
+// ServerGroupResult is the result of a server group Create or Get request.
+type ServerGroupResult struct {
+	eclcloud.Result
+}
+
+// Extract interprets a ServerGroupResult as a ServerGroup.
+func (r ServerGroupResult) Extract() (*ServerGroup, error) {
+	var s struct {
+		ServerGroup *ServerGroup `json:"server_group"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ServerGroup, err
+}
+
+func computeServerGroupV2RootURL(client *eclcloud.ServiceClient) string {
+	return client.ServiceURL("os-server-groups")
+}
+
+func computeServerGroupV2ResourceURL(client *eclcloud.ServiceClient, id string) string {
+	return client.ServiceURL("os-server-groups", id)
+}
+
+// computeServerGroupV2Create requests the creation of a new server group.
+func computeServerGroupV2Create(client *eclcloud.ServiceClient, opts ServerGroupCreateOpts) (r ServerGroupResult) {
+	b, err := opts.ToServerGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(computeServerGroupV2RootURL(client), b, &r.Body, &eclcloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// computeServerGroupV2Get returns data about a previously created server group.
+func computeServerGroupV2Get(client *eclcloud.ServiceClient, id string) (r ServerGroupResult) {
+	_, r.Err = client.Get(computeServerGroupV2ResourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// computeServerGroupV2Delete requests the deletion of a previously created server group.
+func computeServerGroupV2Delete(client *eclcloud.ServiceClient, id string) (r eclcloud.ErrResult) {
+	_, r.Err = client.Delete(computeServerGroupV2ResourceURL(client, id), nil)
+	return
+}
+
+// SchedulerHints represents a set of scheduling hints that are passed to the
+// compute scheduler when a server is created.
+type SchedulerHints struct {
+	// Group specifies a server group to place the server in.
+	Group string `json:"group,omitempty"`
+
+	// DifferentHost will place the server on a compute node that does not
+	// host the given server IDs.
+	DifferentHost []string `json:"different_host,omitempty"`
+
+	// SameHost will place the server on a compute node that hosts the given
+	// server IDs.
+	SameHost []string `json:"same_host,omitempty"`
+}
+
+// SchedulerHintsCreateOptsExt adds scheduler hints to the base CreateOpts.
+type SchedulerHintsCreateOptsExt struct {
+	servers.CreateOptsBuilder
+
+	// SchedulerHints provides a set of hints to the scheduler.
+	SchedulerHints SchedulerHints
+}
+
+// ToServerCreateMap adds the os:scheduler_hints to the base server creation options.
+func (opts SchedulerHintsCreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	hints, err := eclcloud.BuildRequestBody(opts.SchedulerHints, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hints) > 0 {
+		base["os:scheduler_hints"] = hints
+	}
+
+	return base, nil
+}
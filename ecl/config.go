@@ -11,8 +11,10 @@ import (
 	"github.com/nttcom/terraform-provider-ecl/ecl/clientconfig"
 
 	"log"
+	"net"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/pathorcontents"
 	"github.com/hashicorp/terraform/terraform"
@@ -42,7 +44,14 @@ type Config struct {
 	Username          string
 	UserID            string
 
+	MLBAutoApplyConfigurations       bool
+	MLBCancelConfigurationsOnFailure bool
+
 	OsClient *eclcloud.ProviderClient
+
+	mlbConfigurationsApplier *mlbConfigurationsApplierV1
+
+	tlsConfig *tls.Config
 }
 
 func (c *Config) LoadAndValidate() error {
@@ -176,6 +185,8 @@ func (c *Config) LoadAndValidate() error {
 		osDebug = true
 	}
 
+	c.tlsConfig = config
+
 	transport := &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: config}
 	client.HTTPClient = http.Client{
 		Transport: &LogRoundTripper{
@@ -193,6 +204,27 @@ func (c *Config) LoadAndValidate() error {
 	return nil
 }
 
+// httpClient returns a client for servers other than ECL2.0 APIs,
+// e.g. to download images, with the TLS settings of the provider.
+// timeout limits the whole request including reading the response body.
+func (c *Config) httpClient(timeout time.Duration) *http.Client {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig:       c.tlsConfig,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: time.Minute,
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}
+}
+
 func (c *Config) determineRegion(region string) string {
 	// If a resource-level region was not specified, and a provider-level region was set,
 	// use the provider-level region.
@@ -0,0 +1,194 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/baremetal/v2/servers"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceBaremetalServerV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaremetalServerV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"power_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"nic_physical_ports": baremetalServerV2NICPhysicalPortsSchema(),
+		},
+	}
+}
+
+// dataSourceBaremetalServerV2Read performs the server lookup.
+func dataSourceBaremetalServerV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	baremetalClient, err := config.baremetalV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL baremetal client: %s", err)
+	}
+
+	if id := d.Get("server_id").(string); id != "" {
+		server, err := servers.Get(baremetalClient, id).Extract()
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve server %s: %s", id, err)
+		}
+
+		log.Printf("[DEBUG] Single Server found: %s", server.ID)
+		return dataSourceBaremetalServerV2Attributes(d, server)
+	}
+
+	allServers, err := dataSourceBaremetalServerV2List(d, baremetalClient)
+	if err != nil {
+		return err
+	}
+
+	if len(allServers) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allServers) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", allServers)
+		return fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+
+	server := allServers[0]
+	log.Printf("[DEBUG] Single Server found: %s", server.ID)
+	return dataSourceBaremetalServerV2Attributes(d, &server)
+}
+
+// dataSourceBaremetalServerV2Attributes populates the fields of a Server resource.
+func dataSourceBaremetalServerV2Attributes(d *schema.ResourceData, server *servers.Server) error {
+	log.Printf("[DEBUG] ecl_baremetal_server_v2 details: %#v", server)
+
+	d.SetId(server.ID)
+	d.Set("server_id", server.ID)
+	d.Set("name", server.Name)
+	d.Set("status", server.Status)
+	d.Set("flavor_id", baremetalServerV2FlavorID(server))
+	d.Set("image_id", baremetalServerV2ImageID(server))
+	d.Set("availability_zone", server.AvailabilityZone)
+	d.Set("metadata", server.Metadata)
+	d.Set("power_state", baremetalServerV2PowerState(server.PowerState))
+
+	if err := d.Set("nic_physical_ports", getNICPhysicalPortsForState(server)); err != nil {
+		return fmt.Errorf("Unable to set nic_physical_ports: %s", err)
+	}
+
+	return nil
+}
+
+// dataSourceBaremetalServerV2List lists all servers and filters them by the
+// name, status, flavor_id and metadata arguments.
+func dataSourceBaremetalServerV2List(d *schema.ResourceData, client *eclcloud.ServiceClient) ([]servers.Server, error) {
+	listOpts := servers.ListOpts{
+		Name:   d.Get("name").(string),
+		Status: d.Get("status").(string),
+	}
+
+	allPages, err := servers.List(client, listOpts).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to query servers: %s", err)
+	}
+
+	allServers, err := servers.ExtractServers(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve servers: %s", err)
+	}
+
+	var filteredServers []servers.Server
+	for _, server := range allServers {
+		// The name filter of the API is not guaranteed to be an exact match.
+		if v := d.Get("name").(string); v != "" {
+			if server.Name != v {
+				continue
+			}
+		}
+
+		if v := d.Get("flavor_id").(string); v != "" {
+			if baremetalServerV2FlavorID(&server) != v {
+				continue
+			}
+		}
+
+		if !baremetalServerV2MatchMetadata(&server, d.Get("metadata").(map[string]interface{})) {
+			continue
+		}
+
+		filteredServers = append(filteredServers, server)
+	}
+
+	return filteredServers, nil
+}
+
+func baremetalServerV2FlavorID(server *servers.Server) string {
+	if id, ok := server.Flavor["id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+func baremetalServerV2ImageID(server *servers.Server) string {
+	if id, ok := server.Image["id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// baremetalServerV2MatchMetadata reports whether the server has every
+// key/value pair of the given metadata.
+func baremetalServerV2MatchMetadata(server *servers.Server, metadata map[string]interface{}) bool {
+	for k, v := range metadata {
+		if server.Metadata[k] != v.(string) {
+			return false
+		}
+	}
+	return true
+}
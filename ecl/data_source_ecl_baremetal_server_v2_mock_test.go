@@ -0,0 +1,181 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedBaremetalV2ServerDataSource_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "baremetal_servers", "/v2/01234567890123456789abcdefabcdef/servers/detail", testMockBaremetalV2ServersList)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockBaremetalV2ServerDataSourceMetadata,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalV2ServerDataSourceID("data.ecl_baremetal_server_v2.server_1"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_server_v2.server_1", "id", "05184ba3-00ba-4fbc-b7a2-03b62b884931"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_server_v2.server_1", "name", "server1"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_server_v2.server_1", "image_id", "70a599e0-31e7-49b7-b260-868f441e862b"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_server_v2.server_1", "availability_zone", "zone1-groupa"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_server_v2.server_1", "power_state", "on"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_server_v2.server_1", "nic_physical_ports.0.id", "39285bf9-12fb-4064-b98b-a552efc51cfc"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_server_v2.server_1", "nic_physical_ports.0.plane", "data"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_server_v2.server_1", "nic_physical_ports.0.hardware_id", "c1e1546d-3063-46d0-8895-c6350eb691ff"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_server_v2.server_1", "nic_physical_ports.0.attached_ports.0.port_id", "61b7da1e-9571-4d63-b779-e003a56b8105"),
+				),
+			},
+			resource.TestStep{
+				Config: testMockBaremetalV2ServersDataSourceFlavor,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalV2ServerDataSourceID("data.ecl_baremetal_servers_v2.servers_1"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_servers_v2.servers_1", "ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_servers_v2.servers_1", "servers.1.id", "a93b3a1e-1a76-4e3c-9c2d-7cda6f4b2b66"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_servers_v2.servers_1", "servers.1.power_state", "off"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_servers_v2.servers_1", "servers.1.metadata.role", "db"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_servers_v2.servers_1", "servers.1.nic_physical_ports.0.network_physical_port_id", "7c5e3b0c-7ac6-41b3-8f3e-5c3c0b5a1f20"),
+				),
+			},
+		},
+	})
+}
+
+const testMockBaremetalV2ServerDataSourceMetadata = `
+data "ecl_baremetal_server_v2" "server_1" {
+  flavor_id = "05184ba3-00ba-4fbc-b7a2-03b62b884931"
+  metadata = {
+    role = "web"
+  }
+}
+`
+
+const testMockBaremetalV2ServersDataSourceFlavor = `
+data "ecl_baremetal_servers_v2" "servers_1" {
+  flavor_id = "05184ba3-00ba-4fbc-b7a2-03b62b884931"
+}
+`
+
+var testMockBaremetalV2ServersList = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "servers": [
+                {
+                    "OS-EXT-STS:power_state": "RUNNING",
+                    "OS-EXT-AZ:availability_zone": "zone1-groupa",
+                    "created": "2012-09-07T16:56:37Z",
+                    "updated": "2012-09-07T16:56:37Z",
+                    "flavor": {
+                        "id": "05184ba3-00ba-4fbc-b7a2-03b62b884931"
+                    },
+                    "id": "05184ba3-00ba-4fbc-b7a2-03b62b884931",
+                    "image": {
+                        "id": "70a599e0-31e7-49b7-b260-868f441e862b"
+                    },
+                    "metadata": {
+                        "role": "web"
+                    },
+                    "name": "server1",
+                    "status": "ACTIVE",
+                    "nic_physical_ports": [
+                        {
+                            "id": "39285bf9-12fb-4064-b98b-a552efc51cfc",
+                            "mac_addr": "0a:31:c1:d5:6d:9c",
+                            "network_physical_port_id": "38268d94-584a-4f14-96ff-732a68aa7301",
+                            "plane": "data",
+                            "attached_ports": [
+                                {
+                                    "port_id": "61b7da1e-9571-4d63-b779-e003a56b8105",
+                                    "network_id": "9aa93722-1ec4-4912-b813-b975c21460a5",
+                                    "fixed_ips": [
+                                        {
+                                            "subnet_id": "0419bbde-2b82-4107-9d8a-6bba76e364af",
+                                            "ip_address": "192.168.10.2"
+                                        }
+                                    ]
+                                }
+                            ],
+                            "hardware_id": "c1e1546d-3063-46d0-8895-c6350eb691ff"
+                        }
+                    ]
+                },
+                {
+                    "OS-EXT-STS:power_state": "SHUTDOWN",
+                    "OS-EXT-AZ:availability_zone": "zone1-groupa",
+                    "created": "2012-09-07T16:56:37Z",
+                    "updated": "2012-09-07T16:56:37Z",
+                    "flavor": {
+                        "id": "05184ba3-00ba-4fbc-b7a2-03b62b884931"
+                    },
+                    "id": "a93b3a1e-1a76-4e3c-9c2d-7cda6f4b2b66",
+                    "image": {
+                        "id": "70a599e0-31e7-49b7-b260-868f441e862b"
+                    },
+                    "metadata": {
+                        "role": "db"
+                    },
+                    "name": "server2",
+                    "status": "ACTIVE",
+                    "nic_physical_ports": [
+                        {
+                            "id": "f3a5e8a8-0c57-4a1b-9f0b-1c9f3ff0fa6d",
+                            "mac_addr": "0a:31:c1:d5:6d:9d",
+                            "network_physical_port_id": "7c5e3b0c-7ac6-41b3-8f3e-5c3c0b5a1f20",
+                            "plane": "storage",
+                            "attached_ports": [],
+                            "hardware_id": "4a6f8d3e-2d1c-4f5e-8c9b-0a1b2c3d4e5f"
+                        }
+                    ]
+                },
+                {
+                    "OS-EXT-STS:power_state": "RUNNING",
+                    "OS-EXT-AZ:availability_zone": "zone1-groupa",
+                    "created": "2012-09-07T16:56:37Z",
+                    "updated": "2012-09-07T16:56:37Z",
+                    "flavor": {
+                        "id": "8b1d5c7a-3f9e-4b2a-9c6d-7e8f9a0b1c2d"
+                    },
+                    "id": "d2f7a4c1-9e3b-4d8a-b6c5-1f0e2d3c4b5a",
+                    "image": {
+                        "id": "70a599e0-31e7-49b7-b260-868f441e862b"
+                    },
+                    "metadata": {
+                        "role": "web"
+                    },
+                    "name": "server3",
+                    "status": "ACTIVE",
+                    "nic_physical_ports": []
+                }
+            ]
+        }
+`
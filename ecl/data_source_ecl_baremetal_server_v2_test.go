@@ -0,0 +1,73 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBaremetalV2ServerDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckBaremetal(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBaremetalV2ServerBasic,
+			},
+			resource.TestStep{
+				Config: testAccBaremetalV2ServerDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalV2ServerDataSourceID("data.ecl_baremetal_server_v2.server_1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_baremetal_server_v2.server_1", "id",
+						"ecl_baremetal_server_v2.server_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_server_v2.server_1", "name", "server1"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_server_v2.server_1", "metadata.k1", "v1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_baremetal_server_v2.server_1", "nic_physical_ports.0.id",
+						"ecl_baremetal_server_v2.server_1", "nic_physical_ports.0.id"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_baremetal_servers_v2.servers_1", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_baremetal_servers_v2.servers_1", "servers.0.id",
+						"ecl_baremetal_server_v2.server_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBaremetalV2ServerDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find server data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Server data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccBaremetalV2ServerDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "ecl_baremetal_server_v2" "server_1" {
+  name = "${ecl_baremetal_server_v2.server_1.name}"
+  metadata = {
+    k1 = "v1"
+  }
+}
+
+data "ecl_baremetal_servers_v2" "servers_1" {
+  name = "${ecl_baremetal_server_v2.server_1.name}"
+  flavor_id = "${ecl_baremetal_server_v2.server_1.flavor_id}"
+}
+`, testAccBaremetalV2ServerBasic)
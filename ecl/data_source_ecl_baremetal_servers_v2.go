@@ -0,0 +1,128 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceBaremetalServersV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaremetalServersV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flavor_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"power_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nic_physical_ports": baremetalServerV2NICPhysicalPortsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceBaremetalServersV2Read performs the servers lookup.
+func dataSourceBaremetalServersV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	baremetalClient, err := config.baremetalV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL baremetal client: %s", err)
+	}
+
+	allServers, err := dataSourceBaremetalServerV2List(d, baremetalClient)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Servers: %#v", len(allServers), allServers)
+
+	ids := make([]string, 0, len(allServers))
+	result := make([]map[string]interface{}, 0, len(allServers))
+	for _, server := range allServers {
+		ids = append(ids, server.ID)
+		result = append(result, map[string]interface{}{
+			"id":                 server.ID,
+			"name":               server.Name,
+			"status":             server.Status,
+			"flavor_id":          baremetalServerV2FlavorID(&server),
+			"image_id":           baremetalServerV2ImageID(&server),
+			"availability_zone":  server.AvailabilityZone,
+			"metadata":           server.Metadata,
+			"power_state":        baremetalServerV2PowerState(server.PowerState),
+			"nic_physical_ports": getNICPhysicalPortsForState(&server),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(ids, ""))))
+	d.Set("ids", ids)
+
+	if err := d.Set("servers", result); err != nil {
+		return fmt.Errorf("Unable to set servers: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/nttcom/eclcloud/v3/ecl/dedicated_hypervisor/v1/license_types"
+	"github.com/nttcom/eclcloud/v3/ecl/dedicated_hypervisor/v1/licenses"
+)
+
+func dataSourceDedicatedHypervisorLicensesV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDedicatedHypervisorLicensesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"license_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"has_license_key": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_switch": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"licenses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"license_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assigned_from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedHypervisorLicensesV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.dedicatedHypervisorV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL Dedicated Hypervisor client: %s", err)
+	}
+
+	licenseType := d.Get("license_type").(string)
+
+	typePages, err := license_types.List(client).AllPages()
+	if err != nil {
+		return fmt.Errorf("error getting ECL Dedicated Hypervisor license types: %s", err)
+	}
+
+	allTypes, err := license_types.ExtractLicenseTypes(typePages)
+	if err != nil {
+		return fmt.Errorf("error getting ECL Dedicated Hypervisor license types: %s", err)
+	}
+
+	var types []map[string]interface{}
+	for _, t := range allTypes {
+		if licenseType != "" && t.Name != licenseType {
+			continue
+		}
+		types = append(types, map[string]interface{}{
+			"id":              t.ID,
+			"name":            t.Name,
+			"has_license_key": t.HasLicenseKey,
+			"unit":            t.Unit,
+			"license_switch":  t.LicenseSwitch,
+			"description":     t.Description,
+		})
+	}
+
+	opts := licenses.ListOpts{
+		LicenseType: licenseType,
+	}
+
+	licensePages, err := licenses.List(client, opts).AllPages()
+	if err != nil {
+		return fmt.Errorf("error getting ECL Dedicated Hypervisor licenses: %s", err)
+	}
+
+	allLicenses, err := licenses.ExtractLicenses(licensePages)
+	if err != nil {
+		return fmt.Errorf("error getting ECL Dedicated Hypervisor licenses: %s", err)
+	}
+
+	var ids []string
+	var ls []map[string]interface{}
+	for _, l := range allLicenses {
+		ids = append(ids, l.ID)
+		var expiresAt string
+		if l.ExpiresAt != nil {
+			expiresAt = l.ExpiresAt.Format(time.RFC3339)
+		}
+		ls = append(ls, map[string]interface{}{
+			"id":            l.ID,
+			"key":           l.Key,
+			"license_type":  l.LicenseType,
+			"assigned_from": l.AssignedFrom.Format(time.RFC3339),
+			"expires_at":    expiresAt,
+		})
+	}
+
+	log.Printf("[DEBUG] Retrieved Dedicated Hypervisor license types: %#v, licenses: %#v", allTypes, allLicenses)
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(licenseType+strings.Join(ids, ""))))
+	if err := d.Set("license_types", types); err != nil {
+		return fmt.Errorf("error setting license_types: %s", err)
+	}
+	if err := d.Set("licenses", ls); err != nil {
+		return fmt.Errorf("error setting licenses: %s", err)
+	}
+
+	return nil
+}
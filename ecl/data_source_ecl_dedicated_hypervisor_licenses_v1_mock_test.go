@@ -0,0 +1,100 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedDedicatedHypervisorV1LicensesDataSource_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "license_types", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/license_types", testMockDedicatedHypervisorV1LicenseTypesList)
+	mc.Register(t, "licenses", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/licenses", testMockDedicatedHypervisorV1LicensesListQuery)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDedicatedHypervisor(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockDedicatedHypervisorV1LicensesDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "id"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "license_types.#", "1"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "license_types.0.id", "dadbd0b2-0bd2-4b5b-8cd5-44b4b09e7d3f"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "license_types.0.has_license_key", "true"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "license_types.0.unit", "ACU"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "licenses.#", "1"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "licenses.0.id", "0ef7cbd6-bc36-4b04-8a43-98bb1d3c6ffd"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "licenses.0.key", "ABCDE-FGHIJ-KLMNO-PQRST-UVWXY"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "licenses.0.assigned_from", "2019-06-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "licenses.0.expires_at", ""),
+				),
+			},
+		},
+	})
+}
+
+const testMockDedicatedHypervisorV1LicensesDataSourceBasic = `
+data "ecl_dedicated_hypervisor_licenses_v1" "licenses_1" {
+  license_type = "vCenter Server 6.x Standard"
+}
+`
+
+var testMockDedicatedHypervisorV1LicenseTypesList = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "license_types": [
+                {
+                    "id": "dadbd0b2-0bd2-4b5b-8cd5-44b4b09e7d3f",
+                    "name": "vCenter Server 6.x Standard",
+                    "has_license_key": true,
+                    "unit": "ACU",
+                    "license_switch": false,
+                    "description": "vCenter Server 6.x Standard"
+                },
+                {
+                    "id": "e7a1a07b-3a2b-4c8d-9d4b-4f3c2b1a0e9f",
+                    "name": "Windows Server",
+                    "has_license_key": false,
+                    "unit": "vCPU",
+                    "license_switch": true,
+                    "description": "Windows Server"
+                }
+            ]
+        }
+`
+
+var testMockDedicatedHypervisorV1LicensesListQuery = `
+request:
+    method: GET
+    query:
+        license_type:
+            - vCenter Server 6.x Standard
+response:
+    code: 200
+    body: >
+        {
+            "licenses": [
+                {
+                    "id": "0ef7cbd6-bc36-4b04-8a43-98bb1d3c6ffd",
+                    "key": "ABCDE-FGHIJ-KLMNO-PQRST-UVWXY",
+                    "assigned_from": "2019-06-01T00:00:00Z",
+                    "expires_at": null,
+                    "license_type": "vCenter Server 6.x Standard"
+                }
+            ]
+        }
+`
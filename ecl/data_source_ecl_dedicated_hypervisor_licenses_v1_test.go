@@ -0,0 +1,36 @@
+package ecl
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDedicatedHypervisorV1LicensesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDedicatedHypervisor(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedHypervisorV1LicenseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedHypervisorV1LicenseBasic,
+			},
+			{
+				Config: testAccDedicatedHypervisorV1LicensesDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "license_types.#", "1"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "license_types.0.name", "vCenter Server 6.x Standard"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_dedicated_hypervisor_licenses_v1.licenses_1", "licenses.0.id",
+						"ecl_dedicated_hypervisor_license_v1.license_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDedicatedHypervisorV1LicensesDataSourceBasic = testAccDedicatedHypervisorV1LicenseBasic + `
+data "ecl_dedicated_hypervisor_licenses_v1" "licenses_1" {
+  license_type = "${ecl_dedicated_hypervisor_license_v1.license_1.license_type}"
+}
+`
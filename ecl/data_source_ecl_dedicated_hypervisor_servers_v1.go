@@ -0,0 +1,211 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/nttcom/eclcloud/v3/ecl/dedicated_hypervisor/v1/servers"
+)
+
+func dataSourceDedicatedHypervisorServersV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDedicatedHypervisorServersV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hypervisor_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flavor_ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"baremetal_server_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"power_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"managed_by_service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"managed_service_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"networks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"nic_physical_port_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"plane": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"network_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"port_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"fixed_ips": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedHypervisorServersV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.dedicatedHypervisorV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL Dedicated Hypervisor client: %s", err)
+	}
+
+	opts := servers.ListOpts{
+		Name:   d.Get("name").(string),
+		Status: d.Get("status").(string),
+	}
+
+	pages, err := servers.ListDetails(client, opts).AllPages()
+	if err != nil {
+		return fmt.Errorf("error getting ECL Dedicated Hypervisor servers: %s", err)
+	}
+
+	allServers, err := servers.ExtractServers(pages)
+	if err != nil {
+		return fmt.Errorf("error getting ECL Dedicated Hypervisor servers: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved Dedicated Hypervisor servers: %#v", allServers)
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, server := range allServers {
+		if v := d.Get("name").(string); v != "" && server.Name != v {
+			continue
+		}
+		if v := d.Get("status").(string); v != "" && server.Status != v {
+			continue
+		}
+
+		var description string
+		if server.Description != nil {
+			description = *server.Description
+		}
+
+		ids = append(ids, server.ID)
+		result = append(result, map[string]interface{}{
+			"id":                          server.ID,
+			"name":                        server.Name,
+			"description":                 description,
+			"status":                      server.Status,
+			"hypervisor_type":             server.HypervisorType,
+			"image_ref":                   server.ImageRef,
+			"flavor_ref":                  server.BaremetalServer.Flavor.ID,
+			"availability_zone":           server.BaremetalServer.AvailabilityZone,
+			"baremetal_server_id":         server.BaremetalServer.ID,
+			"power_state":                 baremetalServerV2PowerState(server.BaremetalServer.PowerState),
+			"metadata":                    server.BaremetalServer.Metadata,
+			"managed_by_service":          server.BaremetalServer.ManagedByService,
+			"managed_service_resource_id": server.BaremetalServer.ManagedServiceResourceID,
+			"networks":                    dataSourceDedicatedHypervisorNetworksV1(&server),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(ids, ""))))
+	d.Set("ids", ids)
+	if err := d.Set("servers", result); err != nil {
+		return fmt.Errorf("error setting servers: %s", err)
+	}
+
+	return nil
+}
+
+// dataSourceDedicatedHypervisorNetworksV1 flattens the ports attached to the
+// NIC physical ports of the underlying baremetal server.
+func dataSourceDedicatedHypervisorNetworksV1(server *servers.Server) []map[string]interface{} {
+	var networks []map[string]interface{}
+	for _, nic := range server.BaremetalServer.NicPhysicalPorts {
+		for _, port := range nic.AttachedPorts {
+			var fixedIPs []string
+			for _, ip := range port.FixedIPs {
+				fixedIPs = append(fixedIPs, ip.IPAddress)
+			}
+			networks = append(networks, map[string]interface{}{
+				"nic_physical_port_id": nic.ID,
+				"plane":                nic.Plane,
+				"network_id":           port.NetworkID,
+				"port_id":              port.PortID,
+				"fixed_ips":            fixedIPs,
+			})
+		}
+	}
+	return networks
+}
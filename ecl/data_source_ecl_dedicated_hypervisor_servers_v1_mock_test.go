@@ -0,0 +1,137 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedDedicatedHypervisorV1ServersDataSource_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/detail", testMockDedicatedHypervisorV1ServersListDetailsQuery)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDedicatedHypervisor(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockDedicatedHypervisorV1ServersDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "id"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "ids.0", "f42dbc37-4642-4628-8b47-50bf95d8fdd5"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.name", "server1"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.description", "ESXi Dedicated Hypervisor"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.hypervisor_type", "vsphere_esxi"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.flavor_ref", "a830b61c-3155-4a61-b7ed-c450862845e6"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.baremetal_server_id", "24ebe7b8-ecfb-4d9f-a66b-c0120534fc90"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.power_state", "on"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.metadata.vcenter", "vc01"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.networks.#", "2"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.networks.0.network_id", "94055904-6b2c-4839-a14a-c61c93a8bc48"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.networks.0.fixed_ips.0", "192.168.1.10"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.networks.1.nic_physical_port_id", "c4a8d9b0-1a2b-4c3d-8e9f-0a1b2c3d4e5f"),
+				),
+			},
+		},
+	})
+}
+
+const testMockDedicatedHypervisorV1ServersDataSourceBasic = `
+data "ecl_dedicated_hypervisor_servers_v1" "servers_1" {
+  name = "server1"
+  status = "ACTIVE"
+}
+`
+
+var testMockDedicatedHypervisorV1ServersListDetailsQuery = `
+request:
+    method: GET
+    query:
+        name:
+            - server1
+        status:
+            - ACTIVE
+response:
+    code: 200
+    body: >
+        {
+            "servers": [
+                {
+                    "id": "f42dbc37-4642-4628-8b47-50bf95d8fdd5",
+                    "name": "server1",
+                    "imageRef": "dfd25820-b368-4012-997b-29a6d0cf8518",
+                    "description": "ESXi Dedicated Hypervisor",
+                    "status": "ACTIVE",
+                    "hypervisor_type": "vsphere_esxi",
+                    "baremetal_server": {
+                        "OS-EXT-STS:power_state": "RUNNING",
+                        "OS-EXT-AZ:availability_zone": "zone1-groupa",
+                        "created": "2019-10-10T04:11:41Z",
+                        "updated": "2019-10-10T04:14:08Z",
+                        "flavor": {
+                            "id": "a830b61c-3155-4a61-b7ed-c450862845e6"
+                        },
+                        "id": "24ebe7b8-ecfb-4d9f-a66b-c0120534fc90",
+                        "image": {
+                            "id": "112a26a0-ff25-4513-afe1-407e41b0a48b"
+                        },
+                        "metadata": {
+                            "vcenter": "vc01"
+                        },
+                        "name": "server1",
+                        "status": "ACTIVE",
+                        "nic_physical_ports": [
+                            {
+                                "id": "a2f63380-6c77-4cd5-8868-e3556ffd35ce",
+                                "mac_addr": "48:DF:37:90:B4:58",
+                                "plane": "DATA",
+                                "network_physical_port_id": "d8e40a51-f1e2-4681-8953-9fe1e9992c42",
+                                "hardware_id": "be2d30d6-f891-4200-b827-95f229fb8c6b",
+                                "attached_ports": [
+                                    {
+                                        "network_id": "94055904-6b2c-4839-a14a-c61c93a8bc48",
+                                        "port_id": "30fc1c27-fb5f-4955-94d0-a56cd28d09e8",
+                                        "fixed_ips": [
+                                            {
+                                                "subnet_id": "b9f6e0c3-1b6f-4b3a-8c0c-2d5b5a1e4f01",
+                                                "ip_address": "192.168.1.10"
+                                            }
+                                        ]
+                                    }
+                                ]
+                            },
+                            {
+                                "id": "c4a8d9b0-1a2b-4c3d-8e9f-0a1b2c3d4e5f",
+                                "mac_addr": "48:DF:37:90:B4:59",
+                                "plane": "DATA",
+                                "network_physical_port_id": "e1d2c3b4-a5f6-4789-9abc-def012345678",
+                                "hardware_id": "be2d30d6-f891-4200-b827-95f229fb8c6c",
+                                "attached_ports": [
+                                    {
+                                        "network_id": "94055904-6b2c-4839-a14a-c61c93a8bc48",
+                                        "port_id": "5d3e2f1a-0b9c-4d8e-a7f6-5e4d3c2b1a09",
+                                        "fixed_ips": [
+                                            {
+                                                "subnet_id": "b9f6e0c3-1b6f-4b3a-8c0c-2d5b5a1e4f01",
+                                                "ip_address": "192.168.1.11"
+                                            }
+                                        ]
+                                    }
+                                ]
+                            }
+                        ]
+                    }
+                }
+            ]
+        }
+`
@@ -0,0 +1,46 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDedicatedHypervisorV1ServersDataSource_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDedicatedHypervisor(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedHypervisorV1ServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedHypervisorV1ServerBasic,
+			},
+			{
+				Config: testAccDedicatedHypervisorV1ServersDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_dedicated_hypervisor_servers_v1.servers_1", "ids.0",
+						"ecl_dedicated_hypervisor_server_v1.server_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.baremetal_server_id",
+						"ecl_dedicated_hypervisor_server_v1.server_1", "baremetal_server_id"),
+					resource.TestCheckResourceAttr("data.ecl_dedicated_hypervisor_servers_v1.servers_1", "servers.0.networks.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDedicatedHypervisorV1ServersDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "ecl_dedicated_hypervisor_servers_v1" "servers_1" {
+  name = "${ecl_dedicated_hypervisor_server_v1.server_1.name}"
+}
+`, testAccDedicatedHypervisorV1ServerBasic)
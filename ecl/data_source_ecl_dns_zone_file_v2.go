@@ -0,0 +1,76 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/zones"
+)
+
+func dataSourceDNSZoneFileV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDNSZoneFileV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"zone_file": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDNSZoneFileV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return err
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	zone, err := zones.Get(dnsClient, zoneID).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve zone %s: %s", zoneID, err)
+	}
+
+	allRecordSets, err := dnsZoneRecordsV2List(dnsClient, zoneID)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Retrieved DNS record sets of zone %s: %+v", zoneID, allRecordSets)
+
+	// SOA record is generated by the DNS service and is not exported.
+	var rrsets []dnsZoneFileRecordSet
+	for _, rs := range allRecordSets {
+		if strings.ToUpper(rs.Type) == "SOA" {
+			continue
+		}
+		rrsets = append(rrsets, dnsZoneRecordsV2RecordSet(rs))
+	}
+
+	d.SetId(zone.ID)
+	d.Set("name", zone.Name)
+	d.Set("ttl", zone.TTL)
+	d.Set("zone_file", renderDNSZoneFile(zone.Name, zone.TTL, rrsets))
+
+	return nil
+}
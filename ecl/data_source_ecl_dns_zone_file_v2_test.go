@@ -0,0 +1,54 @@
+package ecl
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDNSV2ZoneFileDataSource_basic(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2ZoneFileDataSourceBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneDataSourceID("data.ecl_dns_zone_file_v2.zone_file_1"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_dns_zone_file_v2.zone_file_1", "name", zoneName),
+					resource.TestMatchResourceAttr(
+						"data.ecl_dns_zone_file_v2.zone_file_1", "zone_file",
+						regexp.MustCompile(`(?i)www\.`+regexp.QuoteMeta(zoneName)+`\t3000\tIN\tA\t10\.1\.0\.1`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSV2ZoneFileDataSourceBasic(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "ecl_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email2@example.com"
+			ttl = 6000
+			type = "PRIMARY"
+		}
+
+		resource "ecl_dns_recordset_v2" "recordset_1" {
+			zone_id = "${ecl_dns_zone_v2.zone_1.id}"
+			type = "A"
+			name = "www.%s"
+			ttl = 3000
+			records = ["10.1.0.1"]
+		}
+
+		data "ecl_dns_zone_file_v2" "zone_file_1" {
+			zone_id = "${ecl_dns_recordset_v2.recordset_1.zone_id}"
+		}
+	`, zoneName, zoneName)
+}
@@ -198,29 +198,7 @@ func dataSourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error
 	properties := d.Get("properties").(map[string]interface{})
 	imageProperties := resourceImageStoragesImageV2ExpandProperties(properties)
 	if len(allImages) > 1 && len(imageProperties) > 0 {
-		var filteredImages []images.Image
-		for _, image := range allImages {
-			if len(image.Properties) > 0 {
-				match := true
-				for searchKey, searchValue := range imageProperties {
-					imageValue, ok := image.Properties[searchKey]
-					if !ok {
-						match = false
-						break
-					}
-
-					if searchValue != imageValue {
-						match = false
-						break
-					}
-				}
-
-				if match {
-					filteredImages = append(filteredImages, image)
-				}
-			}
-		}
-		allImages = filteredImages
+		allImages = dataSourceImagesImageV2FilterProperties(allImages, imageProperties)
 	}
 
 	if len(allImages) < 1 {
@@ -277,6 +255,35 @@ func dataSourceImagesImageV2Attributes(d *schema.ResourceData, image *images.Ima
 	return nil
 }
 
+// dataSourceImagesImageV2FilterProperties returns the images having all the
+// given properties.
+func dataSourceImagesImageV2FilterProperties(allImages []images.Image, properties map[string]string) []images.Image {
+	var filteredImages []images.Image
+	for _, image := range allImages {
+		if len(image.Properties) > 0 {
+			match := true
+			for searchKey, searchValue := range properties {
+				imageValue, ok := image.Properties[searchKey]
+				if !ok {
+					match = false
+					break
+				}
+
+				if searchValue != imageValue {
+					match = false
+					break
+				}
+			}
+
+			if match {
+				filteredImages = append(filteredImages, image)
+			}
+		}
+	}
+
+	return filteredImages
+}
+
 type imageSort []images.Image
 
 func (a imageSort) Len() int      { return len(a) }
@@ -0,0 +1,281 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/nttcom/eclcloud/v3/ecl/imagestorage/v2/images"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceImagesImagesV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceImagesImagesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:       schema.TypeString,
+				Optional:   true,
+				Computed:   true,
+				ForceNew:   true,
+				Deprecated: "This attribute is not used to set up the resource.",
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name_regex"},
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"visibility": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"public", "private", "shared", "community",
+				}, false),
+			},
+
+			"member_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"accepted", "pending", "rejected", "all",
+				}, false),
+			},
+
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"size_min": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"size_max": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"sort": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"container_format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disk_format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"min_disk_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"min_ram_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protected": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"visibility": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checksum": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"properties": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"file": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schema": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceImagesImagesV2Read performs the images lookup.
+func dataSourceImagesImagesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL image client: %s", err)
+	}
+
+	var tags []string
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		tags = append(tags, tag.(string))
+	}
+
+	listOpts := images.ListOpts{
+		Name:         d.Get("name").(string),
+		Visibility:   resourceImageStoragesImageV2VisibilityFromString(d.Get("visibility").(string)),
+		Owner:        d.Get("owner").(string),
+		Status:       images.ImageStatusActive,
+		SizeMin:      int64(d.Get("size_min").(int)),
+		SizeMax:      int64(d.Get("size_max").(int)),
+		Sort:         d.Get("sort").(string),
+		Tags:         tags,
+		MemberStatus: resourceImageStoragesImageV2MemberStatusFromString(d.Get("member_status").(string)),
+	}
+
+	log.Printf("[DEBUG] List Options: %#v", listOpts)
+
+	allPages, err := images.List(imageClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query images: %s", err)
+	}
+
+	allImages, err := images.ExtractImages(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve images: %s", err)
+	}
+
+	if v := d.Get("name_regex").(string); v != "" {
+		r := regexp.MustCompile(v)
+		var filteredImages []images.Image
+		for _, image := range allImages {
+			if r.MatchString(image.Name) {
+				filteredImages = append(filteredImages, image)
+			}
+		}
+		allImages = filteredImages
+	}
+
+	properties := d.Get("properties").(map[string]interface{})
+	imageProperties := resourceImageStoragesImageV2ExpandProperties(properties)
+	if len(imageProperties) > 0 {
+		allImages = dataSourceImagesImageV2FilterProperties(allImages, imageProperties)
+	}
+
+	if len(allImages) > 1 && d.Get("most_recent").(bool) {
+		allImages = []images.Image{mostRecentImage(allImages)}
+	}
+
+	log.Printf("[DEBUG] Retrieved images: %#v", allImages)
+
+	ids := make([]string, len(allImages))
+	result := make([]map[string]interface{}, len(allImages))
+	for i, image := range allImages {
+		ids[i] = image.ID
+		result[i] = map[string]interface{}{
+			"id":               image.ID,
+			"name":             image.Name,
+			"tags":             image.Tags,
+			"container_format": image.ContainerFormat,
+			"disk_format":      image.DiskFormat,
+			"min_disk_gb":      image.MinDiskGigabytes,
+			"min_ram_mb":       image.MinRAMMegabytes,
+			"owner":            image.Owner,
+			"protected":        image.Protected,
+			"visibility":       string(image.Visibility),
+			"checksum":         image.Checksum,
+			"size_bytes":       int(image.SizeBytes),
+			"metadata":         image.Metadata,
+			"properties":       resourceImageStoragesImageV2ExpandProperties(image.Properties),
+			"created_at":       image.CreatedAt.Format(time.RFC3339),
+			"updated_at":       image.UpdatedAt.Format(time.RFC3339),
+			"file":             image.File,
+			"schema":           image.Schema,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(ids, ""))))
+	d.Set("ids", ids)
+	if err := d.Set("images", result); err != nil {
+		return fmt.Errorf("Error setting images: %s", err)
+	}
+
+	return nil
+}
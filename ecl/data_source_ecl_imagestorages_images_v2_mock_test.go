@@ -0,0 +1,183 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedImageStoragesV2ImagesDataSource_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "images", "/v2/images", testMockImageStoragesV2ImagesListPage1)
+	mc.Register(t, "images", "/v2/images", testMockImageStoragesV2ImagesListPage2)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockImageStoragesV2ImagesDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_imagestorages_images_v2.images_1", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.ecl_imagestorages_images_v2.images_1", "ids.0", "2e4a6c8e-0b1d-4f3a-9c5e-7a9b1d3f5e03"),
+					resource.TestCheckResourceAttr("data.ecl_imagestorages_images_v2.images_1", "ids.1", "2e4a6c8e-0b1d-4f3a-9c5e-7a9b1d3f5e01"),
+					resource.TestCheckResourceAttr("data.ecl_imagestorages_images_v2.images_1", "images.0.name", "ubuntu-web-20190301"),
+					resource.TestCheckResourceAttr("data.ecl_imagestorages_images_v2.images_1", "images.0.properties.role", "web"),
+					resource.TestCheckResourceAttr("data.ecl_imagestorages_images_v2.images_1", "images.0.created_at", "2019-03-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.ecl_imagestorages_images_v2.images_1", "images.1.name", "ubuntu-web-20190101"),
+					resource.TestCheckResourceAttr("data.ecl_imagestorages_images_v2.images_1", "images.1.size_bytes", "13"),
+				),
+			},
+			resource.TestStep{
+				Config: testMockImageStoragesV2ImagesDataSourceMostRecent,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_imagestorages_images_v2.images_1", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.ecl_imagestorages_images_v2.images_1", "ids.0", "2e4a6c8e-0b1d-4f3a-9c5e-7a9b1d3f5e03"),
+				),
+			},
+		},
+	})
+}
+
+const testMockImageStoragesV2ImagesDataSourceBasic = `
+data "ecl_imagestorages_images_v2" "images_1" {
+  name_regex = "^ubuntu-"
+  tags = ["web"]
+  sort = "created_at:desc"
+
+  properties = {
+    os = "ubuntu"
+    role = "web"
+  }
+}
+`
+
+const testMockImageStoragesV2ImagesDataSourceMostRecent = `
+data "ecl_imagestorages_images_v2" "images_1" {
+  name_regex = "^ubuntu-"
+  tags = ["web"]
+  sort = "created_at:desc"
+  most_recent = true
+
+  properties = {
+    os = "ubuntu"
+    role = "web"
+  }
+}
+`
+
+var testMockImageStoragesV2ImagesListPage1 = `
+request:
+    method: GET
+    query:
+        sort:
+            - created_at:desc
+        status:
+            - active
+        tag:
+            - web
+response:
+    code: 200
+    body: >
+        {
+            "images": [
+                {
+                    "id": "2e4a6c8e-0b1d-4f3a-9c5e-7a9b1d3f5e03",
+                    "name": "ubuntu-web-20190301",
+                    "status": "active",
+                    "tags": ["web"],
+                    "container_format": "bare",
+                    "disk_format": "qcow2",
+                    "min_disk": 0,
+                    "min_ram": 0,
+                    "owner": "01234567890123456789abcdefabcdef",
+                    "protected": false,
+                    "visibility": "private",
+                    "checksum": "5a6a754d94a57f6ff647689acaf0a51f",
+                    "size": 13,
+                    "os": "ubuntu",
+                    "role": "web",
+                    "created_at": "2019-03-01T00:00:00Z",
+                    "updated_at": "2019-03-01T00:00:00Z",
+                    "file": "/v2/images/2e4a6c8e-0b1d-4f3a-9c5e-7a9b1d3f5e03/file",
+                    "schema": "/v2/schemas/image"
+                },
+                {
+                    "id": "2e4a6c8e-0b1d-4f3a-9c5e-7a9b1d3f5e02",
+                    "name": "ubuntu-db-20190201",
+                    "status": "active",
+                    "tags": ["web"],
+                    "container_format": "bare",
+                    "disk_format": "qcow2",
+                    "min_disk": 0,
+                    "min_ram": 0,
+                    "owner": "01234567890123456789abcdefabcdef",
+                    "protected": false,
+                    "visibility": "private",
+                    "checksum": "5a6a754d94a57f6ff647689acaf0a51f",
+                    "size": 13,
+                    "os": "ubuntu",
+                    "role": "db",
+                    "created_at": "2019-02-01T00:00:00Z",
+                    "updated_at": "2019-02-01T00:00:00Z",
+                    "file": "/v2/images/2e4a6c8e-0b1d-4f3a-9c5e-7a9b1d3f5e02/file",
+                    "schema": "/v2/schemas/image"
+                }
+            ],
+            "first": "/v2/images?sort=created_at:desc&status=active&tag=web",
+            "next": "/v2/images?marker=2e4a6c8e-0b1d-4f3a-9c5e-7a9b1d3f5e02&sort=created_at:desc&status=active&tag=web",
+            "schema": "/v2/schemas/images"
+        }
+`
+
+var testMockImageStoragesV2ImagesListPage2 = `
+request:
+    method: GET
+    query:
+        marker:
+            - 2e4a6c8e-0b1d-4f3a-9c5e-7a9b1d3f5e02
+        sort:
+            - created_at:desc
+        status:
+            - active
+        tag:
+            - web
+response:
+    code: 200
+    body: >
+        {
+            "images": [
+                {
+                    "id": "2e4a6c8e-0b1d-4f3a-9c5e-7a9b1d3f5e01",
+                    "name": "ubuntu-web-20190101",
+                    "status": "active",
+                    "tags": ["web"],
+                    "container_format": "bare",
+                    "disk_format": "qcow2",
+                    "min_disk": 0,
+                    "min_ram": 0,
+                    "owner": "01234567890123456789abcdefabcdef",
+                    "protected": false,
+                    "visibility": "private",
+                    "checksum": "5a6a754d94a57f6ff647689acaf0a51f",
+                    "size": 13,
+                    "os": "ubuntu",
+                    "role": "web",
+                    "created_at": "2019-01-01T00:00:00Z",
+                    "updated_at": "2019-01-01T00:00:00Z",
+                    "file": "/v2/images/2e4a6c8e-0b1d-4f3a-9c5e-7a9b1d3f5e01/file",
+                    "schema": "/v2/schemas/image"
+                }
+            ],
+            "first": "/v2/images?sort=created_at:desc&status=active&tag=web",
+            "schema": "/v2/schemas/images"
+        }
+`
@@ -0,0 +1,125 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccImageStoragesV2ImagesDataSource_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			createTemporalImage(localFileForDataSourceTest)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImageStoragesV2ImagesDataSourceImages,
+			},
+			resource.TestStep{
+				Config: testAccImageStoragesV2ImagesDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesV2DataSourceID("data.ecl_imagestorages_images_v2.images_1"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_imagestorages_images_v2.images_1", "ids.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_imagestorages_images_v2.images_1", "ids.0",
+						"ecl_imagestorages_image_v2.image_2", "id"),
+					resource.TestCheckResourceAttr(
+						"data.ecl_imagestorages_images_v2.images_1", "images.0.properties.role", "web"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImageStoragesV2ImagesDataSourceMostRecent,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ecl_imagestorages_images_v2.images_1", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_imagestorages_images_v2.images_1", "ids.0",
+						"ecl_imagestorages_image_v2.image_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccImageStoragesV2ImagesDataSourceImages = fmt.Sprintf(`
+resource "ecl_imagestorages_image_v2" "image_1" {
+  name = "Temp-tf-web-1"
+  container_format = "bare"
+  disk_format = "qcow2"
+  local_file_path = "%s"
+  tags = ["images-tf"]
+  properties = {
+    os = "ubuntu"
+    role = "web"
+  }
+  visibility = "private"
+}
+
+resource "ecl_imagestorages_image_v2" "image_2" {
+  name = "Temp-tf-web-2"
+  container_format = "bare"
+  disk_format = "qcow2"
+  local_file_path = "%s"
+  tags = ["images-tf"]
+  properties = {
+    os = "ubuntu"
+    role = "web"
+  }
+  visibility = "private"
+
+  depends_on = ["ecl_imagestorages_image_v2.image_1"]
+}
+
+resource "ecl_imagestorages_image_v2" "image_3" {
+  name = "Temp-tf-db-1"
+  container_format = "bare"
+  disk_format = "qcow2"
+  local_file_path = "%s"
+  tags = ["images-tf"]
+  properties = {
+    os = "ubuntu"
+    role = "db"
+  }
+  visibility = "private"
+}
+`, localFileForDataSourceTest, localFileForDataSourceTest, localFileForDataSourceTest)
+
+var testAccImageStoragesV2ImagesDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "ecl_imagestorages_images_v2" "images_1" {
+  name_regex = "^Temp-tf-"
+  tags = ["images-tf"]
+  visibility = "private"
+  sort = "created_at:desc"
+
+  properties = {
+    os = "ubuntu"
+    role = "web"
+  }
+}
+`, testAccImageStoragesV2ImagesDataSourceImages)
+
+var testAccImageStoragesV2ImagesDataSourceMostRecent = fmt.Sprintf(`
+%s
+
+data "ecl_imagestorages_images_v2" "images_1" {
+  name_regex = "^Temp-tf-"
+  tags = ["images-tf"]
+  visibility = "private"
+  most_recent = true
+
+  properties = {
+    os = "ubuntu"
+    role = "web"
+  }
+}
+`, testAccImageStoragesV2ImagesDataSourceImages)
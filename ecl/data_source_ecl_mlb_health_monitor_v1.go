@@ -88,6 +88,9 @@ func dataSourceMLBHealthMonitorV1() *schema.Resource {
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema,
+		"port", "protocol", "interval", "retry", "timeout", "path", "http_status_code")
+
 	return result
 }
 
@@ -184,6 +187,19 @@ func dataSourceMLBHealthMonitorV1Read(d *schema.ResourceData, meta interface{})
 
 	d.SetId(healthMonitor.ID)
 
+	current := make([]interface{}, 0, 1)
+	if healthMonitor.ConfigurationStatus != "CREATE_STAGED" {
+		current = append(current, map[string]interface{}{
+			"port":             healthMonitor.Port,
+			"protocol":         healthMonitor.Protocol,
+			"interval":         healthMonitor.Interval,
+			"retry":            healthMonitor.Retry,
+			"timeout":          healthMonitor.Timeout,
+			"path":             healthMonitor.Path,
+			"http_status_code": healthMonitor.HttpStatusCode,
+		})
+	}
+
 	d.Set("name", healthMonitor.Name)
 	d.Set("description", healthMonitor.Description)
 	d.Set("tags", healthMonitor.Tags)
@@ -199,5 +215,7 @@ func dataSourceMLBHealthMonitorV1Read(d *schema.ResourceData, meta interface{})
 	d.Set("path", healthMonitor.Path)
 	d.Set("http_status_code", healthMonitor.HttpStatusCode)
 
+	d.Set("current", current)
+
 	return nil
 }
@@ -54,6 +54,9 @@ func TestMockedAccMLBV1HealthMonitorDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.ecl_mlb_health_monitor_v1.health_monitor_1", "timeout", "5"),
 					resource.TestCheckResourceAttr("data.ecl_mlb_health_monitor_v1.health_monitor_1", "path", "/health"),
 					resource.TestCheckResourceAttr("data.ecl_mlb_health_monitor_v1.health_monitor_1", "http_status_code", "200-299"),
+					resource.TestCheckResourceAttr("data.ecl_mlb_health_monitor_v1.health_monitor_1", "current.#", "1"),
+					resource.TestCheckResourceAttr("data.ecl_mlb_health_monitor_v1.health_monitor_1", "current.0.port", "80"),
+					resource.TestCheckResourceAttr("data.ecl_mlb_health_monitor_v1.health_monitor_1", "current.0.protocol", "http"),
 				),
 			},
 			{
@@ -68,6 +68,9 @@ func dataSourceMLBListenerV1() *schema.Resource {
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema,
+		"ip_address", "port", "protocol")
+
 	return result
 }
 
@@ -148,6 +151,15 @@ func dataSourceMLBListenerV1Read(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(listener.ID)
 
+	current := make([]interface{}, 0, 1)
+	if listener.ConfigurationStatus != "CREATE_STAGED" {
+		current = append(current, map[string]interface{}{
+			"ip_address": listener.IPAddress,
+			"port":       listener.Port,
+			"protocol":   listener.Protocol,
+		})
+	}
+
 	d.Set("name", listener.Name)
 	d.Set("description", listener.Description)
 	d.Set("tags", listener.Tags)
@@ -159,5 +171,7 @@ func dataSourceMLBListenerV1Read(d *schema.ResourceData, meta interface{}) error
 	d.Set("port", listener.Port)
 	d.Set("protocol", listener.Protocol)
 
+	d.Set("current", current)
+
 	return nil
 }
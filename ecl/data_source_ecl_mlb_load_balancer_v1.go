@@ -157,6 +157,9 @@ func dataSourceMLBLoadBalancerV1() *schema.Resource {
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema,
+		"syslog_servers", "interfaces")
+
 	return result
 }
 
@@ -274,6 +277,39 @@ func dataSourceMLBLoadBalancerV1Read(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	current := make([]interface{}, 0, 1)
+	if loadBalancer.ConfigurationStatus != "CREATE_STAGED" {
+		currentSyslogServers := make([]interface{}, len(loadBalancer.SyslogServers))
+		for i, syslogServer := range loadBalancer.SyslogServers {
+			currentSyslogServers[i] = map[string]interface{}{
+				"ip_address": syslogServer.IPAddress,
+				"port":       syslogServer.Port,
+				"protocol":   syslogServer.Protocol,
+			}
+		}
+
+		currentInterfaces := make([]interface{}, len(loadBalancer.Interfaces))
+		for i, interfaceV := range loadBalancer.Interfaces {
+			reservedFixedIPs := make([]interface{}, len(interfaceV.ReservedFixedIPs))
+			for j, reservedFixedIP := range interfaceV.ReservedFixedIPs {
+				reservedFixedIPs[j] = map[string]interface{}{
+					"ip_address": reservedFixedIP.IPAddress,
+				}
+			}
+
+			currentInterfaces[i] = map[string]interface{}{
+				"network_id":         interfaceV.NetworkID,
+				"virtual_ip_address": interfaceV.VirtualIPAddress,
+				"reserved_fixed_ips": reservedFixedIPs,
+			}
+		}
+
+		current = append(current, map[string]interface{}{
+			"syslog_servers": currentSyslogServers,
+			"interfaces":     currentInterfaces,
+		})
+	}
+
 	d.Set("name", loadBalancer.Name)
 	d.Set("description", loadBalancer.Description)
 	d.Set("tags", loadBalancer.Tags)
@@ -290,5 +326,7 @@ func dataSourceMLBLoadBalancerV1Read(d *schema.ResourceData, meta interface{}) e
 	d.Set("syslog_servers", syslogServers)
 	d.Set("interfaces", interfaces)
 
+	d.Set("current", current)
+
 	return nil
 }
@@ -146,6 +146,9 @@ func dataSourceMLBPolicyV1() *schema.Resource {
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema,
+		"algorithm", "persistence", "persistence_timeout", "idle_timeout", "sorry_page_url", "source_nat", "server_name_indications", "certificate_id", "health_monitor_id", "listener_id", "default_target_group_id", "backup_target_group_id", "tls_policy_id")
+
 	return result
 }
 
@@ -272,6 +275,35 @@ func dataSourceMLBPolicyV1Read(d *schema.ResourceData, meta interface{}) error {
 		serverNameIndications[i] = result
 	}
 
+	current := make([]interface{}, 0, 1)
+	if policy.ConfigurationStatus != "CREATE_STAGED" {
+		currentServerNameIndications := make([]interface{}, len(policy.ServerNameIndications))
+		for i, serverNameIndication := range policy.ServerNameIndications {
+			currentServerNameIndications[i] = map[string]interface{}{
+				"server_name":    serverNameIndication.ServerName,
+				"input_type":     serverNameIndication.InputType,
+				"priority":       serverNameIndication.Priority,
+				"certificate_id": serverNameIndication.CertificateID,
+			}
+		}
+
+		current = append(current, map[string]interface{}{
+			"algorithm":               policy.Algorithm,
+			"persistence":             policy.Persistence,
+			"persistence_timeout":     policy.PersistenceTimeout,
+			"idle_timeout":            policy.IdleTimeout,
+			"sorry_page_url":          policy.SorryPageUrl,
+			"source_nat":              policy.SourceNat,
+			"server_name_indications": currentServerNameIndications,
+			"certificate_id":          policy.CertificateID,
+			"health_monitor_id":       policy.HealthMonitorID,
+			"listener_id":             policy.ListenerID,
+			"default_target_group_id": policy.DefaultTargetGroupID,
+			"backup_target_group_id":  policy.BackupTargetGroupID,
+			"tls_policy_id":           policy.TLSPolicyID,
+		})
+	}
+
 	d.Set("name", policy.Name)
 	d.Set("description", policy.Description)
 	d.Set("tags", policy.Tags)
@@ -293,5 +325,7 @@ func dataSourceMLBPolicyV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("backup_target_group_id", policy.BackupTargetGroupID)
 	d.Set("tls_policy_id", policy.TLSPolicyID)
 
+	d.Set("current", current)
+
 	return nil
 }
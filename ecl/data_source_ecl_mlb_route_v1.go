@@ -63,6 +63,9 @@ func dataSourceMLBRouteV1() *schema.Resource {
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema,
+		"next_hop_ip_address")
+
 	return result
 }
 
@@ -139,6 +142,13 @@ func dataSourceMLBRouteV1Read(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(route.ID)
 
+	current := make([]interface{}, 0, 1)
+	if route.ConfigurationStatus != "CREATE_STAGED" {
+		current = append(current, map[string]interface{}{
+			"next_hop_ip_address": route.NextHopIPAddress,
+		})
+	}
+
 	d.Set("name", route.Name)
 	d.Set("description", route.Description)
 	d.Set("tags", route.Tags)
@@ -149,5 +159,7 @@ func dataSourceMLBRouteV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("tenant_id", route.TenantID)
 	d.Set("next_hop_ip_address", route.NextHopIPAddress)
 
+	d.Set("current", current)
+
 	return nil
 }
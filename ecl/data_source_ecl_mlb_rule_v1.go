@@ -96,6 +96,9 @@ func dataSourceMLBRuleV1() *schema.Resource {
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema,
+		"priority", "target_group_id", "backup_target_group_id", "conditions")
+
 	return result
 }
 
@@ -180,6 +183,20 @@ func dataSourceMLBRuleV1Read(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(rule.ID)
 
+	current := make([]interface{}, 0, 1)
+	if rule.ConfigurationStatus != "CREATE_STAGED" {
+		currentConditions := map[string]interface{}{
+			"path_patterns": rule.Conditions.PathPatterns,
+		}
+
+		current = append(current, map[string]interface{}{
+			"priority":               rule.Priority,
+			"target_group_id":        rule.TargetGroupID,
+			"backup_target_group_id": rule.BackupTargetGroupID,
+			"conditions":             []interface{}{currentConditions},
+		})
+	}
+
 	d.Set("name", rule.Name)
 	d.Set("description", rule.Description)
 	d.Set("tags", rule.Tags)
@@ -195,5 +212,7 @@ func dataSourceMLBRuleV1Read(d *schema.ResourceData, meta interface{}) error {
 		"path_patterns": rule.Conditions.PathPatterns,
 	}})
 
+	d.Set("current", current)
+
 	return nil
 }
@@ -82,6 +82,9 @@ func dataSourceMLBTargetGroupV1() *schema.Resource {
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema,
+		"members")
+
 	return result
 }
 
@@ -159,6 +162,22 @@ func dataSourceMLBTargetGroupV1Read(d *schema.ResourceData, meta interface{}) er
 		members[i] = result
 	}
 
+	current := make([]interface{}, 0, 1)
+	if targetGroup.ConfigurationStatus != "CREATE_STAGED" {
+		currentMembers := make([]interface{}, len(targetGroup.Members))
+		for i, member := range targetGroup.Members {
+			currentMembers[i] = map[string]interface{}{
+				"ip_address": member.IPAddress,
+				"port":       member.Port,
+				"weight":     member.Weight,
+			}
+		}
+
+		current = append(current, map[string]interface{}{
+			"members": currentMembers,
+		})
+	}
+
 	d.Set("name", targetGroup.Name)
 	d.Set("description", targetGroup.Description)
 	d.Set("tags", targetGroup.Tags)
@@ -168,5 +187,7 @@ func dataSourceMLBTargetGroupV1Read(d *schema.ResourceData, meta interface{}) er
 	d.Set("tenant_id", targetGroup.TenantID)
 	d.Set("members", members)
 
+	d.Set("current", current)
+
 	return nil
 }
@@ -0,0 +1,41 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkV2AWSGatewayDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckAWSGateway(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkV2AWSGatewayDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_aws_gateway_v2.aws_gateway_1", "id", OS_AWS_GW_ID),
+					resource.TestCheckResourceAttr("data.ecl_network_aws_gateway_v2.aws_gateway_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.ecl_network_aws_gateway_v2.aws_gateway_1", "tenant_id", OS_TENANT_ID),
+					resource.TestCheckResourceAttrSet("data.ecl_network_aws_gateway_v2.aws_gateway_1", "aws_service_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_network_aws_gateway_v2.aws_gateway_2", "id",
+						"data.ecl_network_aws_gateway_v2.aws_gateway_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccNetworkV2AWSGatewayDataSourceBasic = fmt.Sprintf(`
+data "ecl_network_aws_gateway_v2" "aws_gateway_1" {
+	aws_gateway_id = %q
+}
+
+data "ecl_network_aws_gateway_v2" "aws_gateway_2" {
+	name = data.ecl_network_aws_gateway_v2.aws_gateway_1.name
+	aws_service_id = data.ecl_network_aws_gateway_v2.aws_gateway_1.aws_service_id
+}
+`,
+	OS_AWS_GW_ID)
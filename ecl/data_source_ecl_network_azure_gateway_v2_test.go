@@ -0,0 +1,41 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkV2AzureGatewayDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckAzureGateway(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkV2AzureGatewayDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_azure_gateway_v2.azure_gateway_1", "id", OS_AZURE_GW_ID),
+					resource.TestCheckResourceAttr("data.ecl_network_azure_gateway_v2.azure_gateway_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.ecl_network_azure_gateway_v2.azure_gateway_1", "tenant_id", OS_TENANT_ID),
+					resource.TestCheckResourceAttrSet("data.ecl_network_azure_gateway_v2.azure_gateway_1", "azure_service_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_network_azure_gateway_v2.azure_gateway_2", "id",
+						"data.ecl_network_azure_gateway_v2.azure_gateway_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccNetworkV2AzureGatewayDataSourceBasic = fmt.Sprintf(`
+data "ecl_network_azure_gateway_v2" "azure_gateway_1" {
+	azure_gateway_id = %q
+}
+
+data "ecl_network_azure_gateway_v2" "azure_gateway_2" {
+	name = data.ecl_network_azure_gateway_v2.azure_gateway_1.name
+	azure_service_id = data.ecl_network_azure_gateway_v2.azure_gateway_1.azure_service_id
+}
+`,
+	OS_AZURE_GW_ID)
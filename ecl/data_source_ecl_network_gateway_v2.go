@@ -0,0 +1,121 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dataSourceNetworkGatewayV2 returns the data source of the gateways of the
+// collection, e.g. "aws_gateways". The attributes of the service and of the
+// gateway ID are prefixed with kind, e.g. "aws_service_id".
+func dataSourceNetworkGatewayV2(kind, collection string) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Optional: true,
+		},
+		kind + "_service_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Optional: true,
+		},
+		kind + "_gateway_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Optional: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Optional: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"tenant_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	// Inter DC gateways have no QoS option.
+	if kind != "interdc" {
+		s["qos_option_id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+			Optional: true,
+		}
+	}
+
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return dataSourceNetworkGatewayV2Read(d, meta, kind, collection)
+		},
+
+		Schema: s,
+	}
+}
+
+func dataSourceNetworkGatewayV2Read(d *schema.ResourceData, meta interface{}, kind, collection string) error {
+	config := meta.(*Config)
+	client, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	var opts NetworkGatewayV2ListOpts
+
+	if v, ok := d.GetOk("description"); ok {
+		opts.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk(kind + "_service_id"); ok {
+		*opts.serviceID(kind) = v.(string)
+	}
+
+	if v, ok := d.GetOk(kind + "_gateway_id"); ok {
+		opts.ID = v.(string)
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		opts.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("qos_option_id"); ok && kind != "interdc" {
+		opts.QoSOptionID = v.(string)
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		opts.Status = v.(string)
+	}
+
+	if v, ok := d.GetOk("tenant_id"); ok {
+		opts.TenantID = v.(string)
+	}
+
+	gw, err := networkGatewayV2Find(client, collection, opts)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Retrieved %s %s: %+v", collection, gw.ID, gw)
+	d.SetId(gw.ID)
+
+	d.Set("description", gw.Description)
+	d.Set(kind+"_service_id", gw.serviceID(kind))
+	d.Set(kind+"_gateway_id", gw.ID)
+	d.Set("name", gw.Name)
+	if kind != "interdc" {
+		d.Set("qos_option_id", gw.QoSOptionID)
+	}
+	d.Set("status", gw.Status)
+	d.Set("tenant_id", gw.TenantID)
+
+	return nil
+}
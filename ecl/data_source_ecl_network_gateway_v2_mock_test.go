@@ -0,0 +1,112 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedAccNetworkV2GatewayDataSource_name(t *testing.T) {
+	testCases := []struct {
+		kind        string
+		name        string
+		description string
+		n           int
+		qosOption   bool
+	}{
+		{"aws", "AWS-GW-01", "test AWS Gateway", 1, true},
+		{"azure", "Azure-GW-01", "test Azure Gateway", 2, true},
+		{"gcp", "GCP-GW-01", "test GCP Gateway", 3, true},
+		{"vpn", "VPN-GW-01", "test VPN Gateway", 4, true},
+		{"interdc", "InterDC-GW-01", "test Inter DC Gateway", 5, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.kind, func(t *testing.T) {
+			collection := tc.kind + "_gateways"
+			gatewayID := fmt.Sprintf("4c1e2f3a-6b7c-4d8e-9f0a-1b2c3d4e5f%02d", tc.n)
+			serviceID := fmt.Sprintf("5d2f3a4b-7c8d-4e9f-8a1b-2c3d4e5f6a%02d", tc.n)
+			qosOptionID := ""
+			if tc.qosOption {
+				qosOptionID = "d384d7f5-22aa-46e5-8cf5-759e87c7b2fd"
+			}
+
+			mc := mock.NewMockController()
+			defer mc.TerminateMockControllerSafety()
+
+			postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+			mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+			mc.Register(t, collection, "/v2.0/"+collection,
+				testMockNetworkV2GatewayListNameQuery(tc.kind, tc.name, tc.description, gatewayID, serviceID, qosOptionID))
+			mc.StartServer(t)
+
+			address := fmt.Sprintf("data.ecl_network_%s_gateway_v2.%s_gateway_1", tc.kind, tc.kind)
+			checks := []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr(address, "id", gatewayID),
+				resource.TestCheckResourceAttr(address, "description", tc.description),
+				resource.TestCheckResourceAttr(address, tc.kind+"_service_id", serviceID),
+				resource.TestCheckResourceAttr(address, tc.kind+"_gateway_id", gatewayID),
+				resource.TestCheckResourceAttr(address, "name", tc.name),
+				resource.TestCheckResourceAttr(address, "status", "ACTIVE"),
+				resource.TestCheckResourceAttr(address, "tenant_id", OS_TENANT_ID),
+			}
+			if tc.qosOption {
+				checks = append(checks, resource.TestCheckResourceAttr(address, "qos_option_id", qosOptionID))
+			} else {
+				checks = append(checks, resource.TestCheckNoResourceAttr(address, "qos_option_id"))
+			}
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { testAccPreCheck(t) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(testMockedAccNetworkV2GatewayDataSourceName, tc.kind, tc.kind, tc.name),
+						Check:  resource.ComposeTestCheckFunc(checks...),
+					},
+				},
+			})
+		})
+	}
+}
+
+const testMockedAccNetworkV2GatewayDataSourceName = `
+data "ecl_network_%s_gateway_v2" "%s_gateway_1" {
+  name = "%s"
+}
+`
+
+func testMockNetworkV2GatewayListNameQuery(kind, name, description, gatewayID, serviceID, qosOptionID string) string {
+	var qosOption string
+	if qosOptionID != "" {
+		qosOption = fmt.Sprintf(`
+              "qos_option_id": "%s",`, qosOptionID)
+	}
+
+	return fmt.Sprintf(`
+request:
+    method: GET
+    query:
+        name:
+            - %s
+response:
+    code: 200
+    body: >
+        {
+          "%s_gateways": [
+            {
+              "%s_service_id": "%s",
+              "description": "%s",
+              "id": "%s",
+              "name": "%s",%s
+              "status": "ACTIVE",
+              "tenant_id": "%s"
+            }
+          ]
+        }
+`, name, kind, kind, serviceID, description, gatewayID, name, qosOption, OS_TENANT_ID)
+}
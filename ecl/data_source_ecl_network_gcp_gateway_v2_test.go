@@ -0,0 +1,41 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkV2GCPGatewayDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckGCPGateway(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkV2GCPGatewayDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_gcp_gateway_v2.gcp_gateway_1", "id", OS_GCP_GW_ID),
+					resource.TestCheckResourceAttr("data.ecl_network_gcp_gateway_v2.gcp_gateway_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.ecl_network_gcp_gateway_v2.gcp_gateway_1", "tenant_id", OS_TENANT_ID),
+					resource.TestCheckResourceAttrSet("data.ecl_network_gcp_gateway_v2.gcp_gateway_1", "gcp_service_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_network_gcp_gateway_v2.gcp_gateway_2", "id",
+						"data.ecl_network_gcp_gateway_v2.gcp_gateway_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccNetworkV2GCPGatewayDataSourceBasic = fmt.Sprintf(`
+data "ecl_network_gcp_gateway_v2" "gcp_gateway_1" {
+	gcp_gateway_id = %q
+}
+
+data "ecl_network_gcp_gateway_v2" "gcp_gateway_2" {
+	name = data.ecl_network_gcp_gateway_v2.gcp_gateway_1.name
+	gcp_service_id = data.ecl_network_gcp_gateway_v2.gcp_gateway_1.gcp_service_id
+}
+`,
+	OS_GCP_GW_ID)
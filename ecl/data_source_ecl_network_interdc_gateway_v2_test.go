@@ -0,0 +1,41 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkV2InterDCGatewayDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckInterDCGateway(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkV2InterDCGatewayDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_interdc_gateway_v2.interdc_gateway_1", "id", OS_INTERDC_GW_ID),
+					resource.TestCheckResourceAttr("data.ecl_network_interdc_gateway_v2.interdc_gateway_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.ecl_network_interdc_gateway_v2.interdc_gateway_1", "tenant_id", OS_TENANT_ID),
+					resource.TestCheckResourceAttrSet("data.ecl_network_interdc_gateway_v2.interdc_gateway_1", "interdc_service_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_network_interdc_gateway_v2.interdc_gateway_2", "id",
+						"data.ecl_network_interdc_gateway_v2.interdc_gateway_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccNetworkV2InterDCGatewayDataSourceBasic = fmt.Sprintf(`
+data "ecl_network_interdc_gateway_v2" "interdc_gateway_1" {
+	interdc_gateway_id = %q
+}
+
+data "ecl_network_interdc_gateway_v2" "interdc_gateway_2" {
+	name = data.ecl_network_interdc_gateway_v2.interdc_gateway_1.name
+	interdc_service_id = data.ecl_network_interdc_gateway_v2.interdc_gateway_1.interdc_service_id
+}
+`,
+	OS_INTERDC_GW_ID)
@@ -0,0 +1,188 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/load_balancer_interfaces"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetworkLoadBalancerInterfaceV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkLoadBalancerInterfaceV2Read,
+
+		Schema: map[string]*schema.Schema{
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"slot_number": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"virtual_ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"virtual_ip_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkLoadBalancerInterfaceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	var opts load_balancer_interfaces.ListOpts
+
+	if v, ok := d.GetOk("description"); ok {
+		opts.Description = v.(string)
+	}
+	if v, ok := d.GetOk("id"); ok {
+		opts.ID = v.(string)
+	}
+	if v, ok := d.GetOk("ip_address"); ok {
+		opts.IPAddress = v.(string)
+	}
+	if v, ok := d.GetOk("load_balancer_id"); ok {
+		opts.LoadBalancerID = v.(string)
+	}
+	if v, ok := d.GetOk("name"); ok {
+		opts.Name = v.(string)
+	}
+	if v, ok := d.GetOk("network_id"); ok {
+		opts.NetworkID = v.(string)
+	}
+	if v, ok := d.GetOk("slot_number"); ok {
+		opts.SlotNumber = v.(int)
+	}
+	if v, ok := d.GetOk("status"); ok {
+		opts.Status = v.(string)
+	}
+	if v, ok := d.GetOk("tenant_id"); ok {
+		opts.TenantID = v.(string)
+	}
+	if v, ok := d.GetOk("virtual_ip_address"); ok {
+		opts.VirtualIPAddress = v.(string)
+	}
+
+	pages, err := load_balancer_interfaces.List(networkClient, opts).AllPages()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve Load Balancer Interfaces: %w", err)
+	}
+
+	allInterfaces, err := load_balancer_interfaces.ExtractLoadBalancerInterfaces(pages)
+	if err != nil {
+		return fmt.Errorf("unable to extract retrieved Load Balancer Interfaces: %w", err)
+	}
+
+	if len(allInterfaces) > 1 {
+		return fmt.Errorf("specified Load Balancer Interface query returned more than one result")
+	}
+
+	if len(allInterfaces) == 0 {
+		return fmt.Errorf("specified Load Balancer Interface query returned no results")
+	}
+
+	lbInterface := allInterfaces[0]
+
+	log.Printf("[DEBUG] Retrieved Load Balancer Interface %s: %+v", lbInterface.ID, lbInterface)
+
+	d.SetId(lbInterface.ID)
+	d.Set("description", lbInterface.Description)
+	d.Set("id", lbInterface.ID)
+	d.Set("ip_address", lbInterface.IPAddress)
+	d.Set("load_balancer_id", lbInterface.LoadBalancerID)
+	d.Set("name", lbInterface.Name)
+	d.Set("network_id", lbInterface.NetworkID)
+	d.Set("slot_number", lbInterface.SlotNumber)
+	d.Set("status", lbInterface.Status)
+	d.Set("tenant_id", lbInterface.TenantID)
+	d.Set("type", lbInterface.Type)
+	d.Set("virtual_ip_address", lbInterface.VirtualIPAddress)
+
+	var virtualIPProperties []interface{}
+	if v := lbInterface.VirtualIPProperties; v != nil {
+		virtualIPProperties = append(virtualIPProperties, map[string]interface{}{
+			"protocol": v.Protocol,
+			"vrid":     v.Vrid,
+		})
+	}
+	d.Set("virtual_ip_properties", virtualIPProperties)
+
+	return nil
+}
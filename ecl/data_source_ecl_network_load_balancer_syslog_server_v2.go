@@ -0,0 +1,204 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/load_balancer_syslog_servers"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetworkLoadBalancerSyslogServerV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkLoadBalancerSyslogServerV2Read,
+
+		Schema: map[string]*schema.Schema{
+
+			"acl_logging": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"appflow_logging": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"date_format": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"log_facility": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"log_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"port_number": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"priority": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tcp_logging": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"time_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"transport_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"user_configurable_log_messages": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkLoadBalancerSyslogServerV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	var opts load_balancer_syslog_servers.ListOpts
+
+	if v, ok := d.GetOk("description"); ok {
+		opts.Description = v.(string)
+	}
+	if v, ok := d.GetOk("id"); ok {
+		opts.ID = v.(string)
+	}
+	if v, ok := d.GetOk("ip_address"); ok {
+		opts.IPAddress = v.(string)
+	}
+	if v, ok := d.GetOk("load_balancer_id"); ok {
+		opts.LoadBalancerID = v.(string)
+	}
+	if v, ok := d.GetOk("log_facility"); ok {
+		opts.LogFacility = v.(string)
+	}
+	if v, ok := d.GetOk("log_level"); ok {
+		opts.LogLevel = v.(string)
+	}
+	if v, ok := d.GetOk("name"); ok {
+		opts.Name = v.(string)
+	}
+	if v, ok := d.GetOk("port_number"); ok {
+		opts.PortNumber = v.(int)
+	}
+	if v, ok := d.GetOk("status"); ok {
+		opts.Status = v.(string)
+	}
+	if v, ok := d.GetOk("transport_type"); ok {
+		opts.TransportType = v.(string)
+	}
+
+	pages, err := load_balancer_syslog_servers.List(networkClient, opts).AllPages()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve Load Balancer Syslog Servers: %w", err)
+	}
+
+	allSyslogServers, err := load_balancer_syslog_servers.ExtractLoadBalancerSyslogServers(pages)
+	if err != nil {
+		return fmt.Errorf("unable to extract retrieved Load Balancer Syslog Servers: %w", err)
+	}
+
+	if len(allSyslogServers) > 1 {
+		return fmt.Errorf("specified Load Balancer Syslog Server query returned more than one result")
+	}
+
+	if len(allSyslogServers) == 0 {
+		return fmt.Errorf("specified Load Balancer Syslog Server query returned no results")
+	}
+
+	syslogServer := allSyslogServers[0]
+
+	log.Printf("[DEBUG] Retrieved Load Balancer Syslog Server %s: %+v", syslogServer.ID, syslogServer)
+
+	d.SetId(syslogServer.ID)
+	d.Set("acl_logging", syslogServer.AclLogging)
+	d.Set("appflow_logging", syslogServer.AppflowLogging)
+	d.Set("date_format", syslogServer.DateFormat)
+	d.Set("description", syslogServer.Description)
+	d.Set("id", syslogServer.ID)
+	d.Set("ip_address", syslogServer.IPAddress)
+	d.Set("load_balancer_id", syslogServer.LoadBalancerID)
+	d.Set("log_facility", syslogServer.LogFacility)
+	d.Set("log_level", syslogServer.LogLevel)
+	d.Set("name", syslogServer.Name)
+	d.Set("port_number", syslogServer.PortNumber)
+	d.Set("priority", syslogServer.Priority)
+	d.Set("status", syslogServer.Status)
+	d.Set("tcp_logging", syslogServer.TcpLogging)
+	d.Set("tenant_id", syslogServer.TenantID)
+	d.Set("time_zone", syslogServer.TimeZone)
+	d.Set("transport_type", syslogServer.TransportType)
+	d.Set("user_configurable_log_messages", syslogServer.UserConfigurableLogMessages)
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/networks"
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/subnets"
+)
+
+func dataSourceNetworkNetworksV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkNetworksV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"matching_subnet_cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"plane": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["tenant_id"],
+			},
+
+			// Computed values
+			"ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"networks": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"admin_state_up": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"plane": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnets": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"tags": &schema.Schema{
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"tenant_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkNetworksV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	listOpts := networks.ListOpts{
+		Description: d.Get("description").(string),
+		ID:          d.Get("network_id").(string),
+		Name:        d.Get("name").(string),
+		Plane:       d.Get("plane").(string),
+		Status:      d.Get("status").(string),
+		TenantID:    d.Get("tenant_id").(string),
+	}
+
+	allNetworks, err := dataSourceNetworkNetworksV2List(networkClient, listOpts)
+	if err != nil {
+		return err
+	}
+
+	tags := resourceTags(d)
+	cidr := d.Get("matching_subnet_cidr").(string)
+
+	var refinedNetworks []networks.Network
+	for _, n := range allNetworks {
+		if !networkV2TagsMatch(n.Tags, tags) {
+			continue
+		}
+
+		if cidr != "" {
+			matched, err := dataSourceNetworkNetworksV2MatchSubnetCIDR(networkClient, n, cidr)
+			if err != nil {
+				return err
+			}
+			if !matched {
+				continue
+			}
+		}
+
+		refinedNetworks = append(refinedNetworks, n)
+	}
+
+	log.Printf("[DEBUG] Retrieved Networks: %+v", refinedNetworks)
+
+	ids := make([]string, len(refinedNetworks))
+	result := make([]map[string]interface{}, len(refinedNetworks))
+	for i, n := range refinedNetworks {
+		ids[i] = n.ID
+		result[i] = map[string]interface{}{
+			"id":             n.ID,
+			"admin_state_up": n.AdminStateUp,
+			"description":    n.Description,
+			"name":           n.Name,
+			"plane":          n.Plane,
+			"status":         n.Status,
+			"subnets":        n.Subnets,
+			"tags":           n.Tags,
+			"tenant_id":      n.TenantID,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(ids, ""))))
+	d.Set("ids", ids)
+	if err := d.Set("networks", result); err != nil {
+		return fmt.Errorf("Unable to set networks: %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceNetworkNetworksV2List(networkClient *eclcloud.ServiceClient, listOpts networks.ListOpts) ([]networks.Network, error) {
+	pages, err := networks.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list networks: %s", err)
+	}
+
+	allNetworks, err := networks.ExtractNetworks(pages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve networks: %s", err)
+	}
+
+	return allNetworks, nil
+}
+
+func dataSourceNetworkNetworksV2MatchSubnetCIDR(networkClient *eclcloud.ServiceClient, n networks.Network, cidr string) (bool, error) {
+	for _, s := range n.Subnets {
+		subnet, err := subnets.Get(networkClient, s).Extract()
+		if err != nil {
+			if _, ok := err.(eclcloud.ErrDefault404); ok {
+				continue
+			}
+			return false, fmt.Errorf("Unable to retrieve network subnet: %s", err)
+		}
+		if cidr == subnet.CIDR {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,132 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedNetworkV2NetworksDataSource_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "networks", "/v2.0/networks", fmt.Sprintf(testMockNetworkV2NetworksListPage1, mc.Endpoint(), OS_TENANT_ID))
+	mc.Register(t, "networks", "/v2.0/networks", fmt.Sprintf(testMockNetworkV2NetworksListPage2, OS_TENANT_ID))
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockNetworkV2NetworksDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_networks_v2.networks_1", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.ecl_network_networks_v2.networks_1", "ids.0", "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01"),
+					resource.TestCheckResourceAttr("data.ecl_network_networks_v2.networks_1", "ids.1", "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a03"),
+					resource.TestCheckResourceAttr("data.ecl_network_networks_v2.networks_1", "networks.#", "2"),
+					resource.TestCheckResourceAttr("data.ecl_network_networks_v2.networks_1", "networks.1.name", "network_3"),
+					resource.TestCheckResourceAttr("data.ecl_network_networks_v2.networks_1", "networks.1.subnets.#", "1"),
+					resource.TestCheckResourceAttr("data.ecl_network_networks_v2.networks_1", "networks.1.tags.role", "web"),
+				),
+			},
+		},
+	})
+}
+
+const testMockNetworkV2NetworksDataSourceBasic = `
+data "ecl_network_networks_v2" "networks_1" {
+  plane = "data"
+  tags = {
+    role = "web"
+  }
+}
+`
+
+var testMockNetworkV2NetworksListPage1 = `
+request:
+    method: GET
+    query:
+        plane:
+            - data
+response:
+    code: 200
+    body: >
+        {
+          "networks": [
+            {
+              "admin_state_up": true,
+              "description": "",
+              "id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+              "name": "network_1",
+              "plane": "data",
+              "shared": false,
+              "status": "ACTIVE",
+              "subnets": [],
+              "tags": {
+                "role": "web",
+                "env": "test"
+              },
+              "tenant_id": "%[2]s"
+            },
+            {
+              "admin_state_up": true,
+              "description": "",
+              "id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a02",
+              "name": "network_2",
+              "plane": "data",
+              "shared": false,
+              "status": "ACTIVE",
+              "subnets": [],
+              "tags": {
+                "role": "db"
+              },
+              "tenant_id": "%[2]s"
+            }
+          ],
+          "networks_links": [
+            {
+              "href": "%[1]sv2.0/networks?marker=8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a02&plane=data",
+              "rel": "next"
+            }
+          ]
+        }
+`
+
+var testMockNetworkV2NetworksListPage2 = `
+request:
+    method: GET
+    query:
+        marker:
+            - 8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a02
+        plane:
+            - data
+response:
+    code: 200
+    body: >
+        {
+          "networks": [
+            {
+              "admin_state_up": true,
+              "description": "",
+              "id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a03",
+              "name": "network_3",
+              "plane": "data",
+              "shared": false,
+              "status": "ACTIVE",
+              "subnets": [
+                "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+              ],
+              "tags": {
+                "role": "web"
+              },
+              "tenant_id": "%s"
+            }
+          ]
+        }
+`
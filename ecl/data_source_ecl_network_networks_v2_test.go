@@ -0,0 +1,61 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkV2NetworksDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkV2NetworksDataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_networks_v2.networks_1", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_network_networks_v2.networks_1", "ids.0",
+						"ecl_network_network_v2.network_1", "id"),
+					resource.TestCheckResourceAttr("data.ecl_network_networks_v2.networks_1", "networks.0.name", name),
+					resource.TestCheckResourceAttr("data.ecl_network_networks_v2.networks_1", "networks.0.tags.role", "web"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkV2NetworksDataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+resource "ecl_network_network_v2" "network_1" {
+  name = "%s"
+  plane = "data"
+  tags = {
+    role = "web"
+  }
+}
+
+resource "ecl_network_network_v2" "network_2" {
+  name = "%s"
+  plane = "data"
+  tags = {
+    role = "db"
+  }
+}
+
+data "ecl_network_networks_v2" "networks_1" {
+  name = "${ecl_network_network_v2.network_1.name}"
+  plane = "data"
+  tags = {
+    role = "web"
+  }
+  depends_on = ["ecl_network_network_v2.network_2"]
+}
+`, name, name)
+}
@@ -0,0 +1,254 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/ports"
+)
+
+func dataSourceNetworkPortsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkPortsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device_owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fixed_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mac_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"segmentation_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"segmentation_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"all_fixed_ips": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_address_pairs": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"mac_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"managed_by_service": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"segmentation_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"segmentation_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkPortsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	listOpts := ports.ListOpts{
+		Description:      d.Get("description").(string),
+		DeviceID:         d.Get("device_id").(string),
+		DeviceOwner:      d.Get("device_owner").(string),
+		ID:               d.Get("port_id").(string),
+		MACAddress:       d.Get("mac_address").(string),
+		Name:             d.Get("name").(string),
+		NetworkID:        d.Get("network_id").(string),
+		SegmentationID:   d.Get("segmentation_id").(int),
+		SegmentationType: d.Get("segmentation_type").(string),
+		Status:           d.Get("status").(string),
+		TenantID:         d.Get("tenant_id").(string),
+	}
+
+	allPages, err := ports.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list ecl_network_ports_v2: %s", err)
+	}
+
+	var allPorts []ports.Port
+	if err := ports.ExtractPortsInto(allPages, &allPorts); err != nil {
+		return fmt.Errorf("Unable to retrieve ecl_network_ports_v2: %s", err)
+	}
+
+	fixedIP := d.Get("fixed_ip").(string)
+	tags := resourceTags(d)
+
+	var portsList []ports.Port
+	for _, p := range allPorts {
+		if fixedIP != "" && !dataSourceNetworkPortsV2HasFixedIP(p, fixedIP) {
+			continue
+		}
+		if !networkV2TagsMatch(p.Tags, tags) {
+			continue
+		}
+		portsList = append(portsList, p)
+	}
+
+	log.Printf("[DEBUG] Retrieved ecl_network_ports_v2: %+v", portsList)
+
+	ids := make([]string, len(portsList))
+	result := make([]map[string]interface{}, len(portsList))
+	for i, p := range portsList {
+		ids[i] = p.ID
+		result[i] = map[string]interface{}{
+			"id":                    p.ID,
+			"admin_state_up":        p.AdminStateUp,
+			"all_fixed_ips":         expandNetworkPortFixedIPToStringSlice(p.FixedIPs),
+			"allowed_address_pairs": flattenNetworkPortAllowedAddressPairsV2(p.MACAddress, p.AllowedAddressPairs),
+			"description":           p.Description,
+			"device_id":             p.DeviceID,
+			"device_owner":          p.DeviceOwner,
+			"mac_address":           p.MACAddress,
+			"managed_by_service":    p.ManagedByService,
+			"name":                  p.Name,
+			"network_id":            p.NetworkID,
+			"segmentation_id":       p.SegmentationID,
+			"segmentation_type":     p.SegmentationType,
+			"status":                p.Status,
+			"tags":                  p.Tags,
+			"tenant_id":             p.TenantID,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(ids, ""))))
+	d.Set("ids", ids)
+	if err := d.Set("ports", result); err != nil {
+		return fmt.Errorf("Unable to set ports: %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceNetworkPortsV2HasFixedIP(p ports.Port, fixedIP string) bool {
+	for _, ip := range p.FixedIPs {
+		if ip.IPAddress == fixedIP {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedNetworkV2PortsDataSource_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "ports", "/v2.0/ports", testMockNetworkV2PortsList)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockNetworkV2PortsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_ports_v2.ports_1", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.ecl_network_ports_v2.ports_1", "ports.0.name", "port_1"),
+					resource.TestCheckResourceAttr("data.ecl_network_ports_v2.ports_1", "ports.0.all_fixed_ips.0", "192.168.1.11"),
+					resource.TestCheckResourceAttr("data.ecl_network_ports_v2.ports_1", "ports.1.name", "port_3"),
+				),
+			},
+			resource.TestStep{
+				Config: testMockNetworkV2PortsDataSourceFixedIP,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_ports_v2.ports_1", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.ecl_network_ports_v2.ports_1", "ids.0", "0c3d4e5f-3333-4a7b-8c1d-2e3f4a5b6c03"),
+				),
+			},
+		},
+	})
+}
+
+const testMockNetworkV2PortsDataSourceBasic = `
+data "ecl_network_ports_v2" "ports_1" {
+  network_id = "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01"
+  tags = {
+    role = "web"
+  }
+}
+`
+
+const testMockNetworkV2PortsDataSourceFixedIP = `
+data "ecl_network_ports_v2" "ports_1" {
+  network_id = "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01"
+  fixed_ip = "192.168.1.13"
+  tags = {
+    role = "web"
+  }
+}
+`
+
+var testMockNetworkV2PortsList = fmt.Sprintf(`
+request:
+    method: GET
+    query:
+        network_id:
+            - 8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01
+response:
+    code: 200
+    body: >
+        {
+          "ports": [
+            {
+              "admin_state_up": true,
+              "allowed_address_pairs": [],
+              "description": "",
+              "device_id": "",
+              "device_owner": "",
+              "fixed_ips": [
+                {
+                  "ip_address": "192.168.1.11",
+                  "subnet_id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+                }
+              ],
+              "id": "0c3d4e5f-3333-4a7b-8c1d-2e3f4a5b6c01",
+              "mac_address": "fa:16:3e:00:00:01",
+              "managed_by_service": false,
+              "name": "port_1",
+              "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+              "segmentation_id": 0,
+              "segmentation_type": "flat",
+              "status": "ACTIVE",
+              "tags": {
+                "role": "web"
+              },
+              "tenant_id": "%[1]s"
+            },
+            {
+              "admin_state_up": true,
+              "allowed_address_pairs": [],
+              "description": "",
+              "device_id": "",
+              "device_owner": "",
+              "fixed_ips": [
+                {
+                  "ip_address": "192.168.1.12",
+                  "subnet_id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+                }
+              ],
+              "id": "0c3d4e5f-3333-4a7b-8c1d-2e3f4a5b6c02",
+              "mac_address": "fa:16:3e:00:00:02",
+              "managed_by_service": false,
+              "name": "port_2",
+              "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+              "segmentation_id": 0,
+              "segmentation_type": "flat",
+              "status": "ACTIVE",
+              "tags": {
+                "role": "db"
+              },
+              "tenant_id": "%[1]s"
+            },
+            {
+              "admin_state_up": true,
+              "allowed_address_pairs": [],
+              "description": "",
+              "device_id": "",
+              "device_owner": "",
+              "fixed_ips": [
+                {
+                  "ip_address": "192.168.1.13",
+                  "subnet_id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+                }
+              ],
+              "id": "0c3d4e5f-3333-4a7b-8c1d-2e3f4a5b6c03",
+              "mac_address": "fa:16:3e:00:00:03",
+              "managed_by_service": false,
+              "name": "port_3",
+              "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+              "segmentation_id": 0,
+              "segmentation_type": "flat",
+              "status": "ACTIVE",
+              "tags": {
+                "role": "web"
+              },
+              "tenant_id": "%[1]s"
+            }
+          ]
+        }
+`, OS_TENANT_ID)
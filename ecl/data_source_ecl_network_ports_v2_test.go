@@ -0,0 +1,77 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkV2PortsDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkV2PortsDataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_ports_v2.ports_1", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_network_ports_v2.ports_1", "ids.0",
+						"ecl_network_port_v2.port_1", "id"),
+					resource.TestCheckResourceAttr("data.ecl_network_ports_v2.ports_1", "ports.0.all_fixed_ips.0", "192.168.199.11"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkV2PortsDataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+resource "ecl_network_network_v2" "network_1" {
+  name = "%s"
+}
+
+resource "ecl_network_subnet_v2" "subnet_1" {
+  name = "%s"
+  cidr = "192.168.199.0/24"
+  network_id = "${ecl_network_network_v2.network_1.id}"
+}
+
+resource "ecl_network_port_v2" "port_1" {
+  name = "%s"
+  network_id = "${ecl_network_network_v2.network_1.id}"
+  fixed_ip {
+    subnet_id = "${ecl_network_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.11"
+  }
+  tags = {
+    role = "web"
+  }
+}
+
+resource "ecl_network_port_v2" "port_2" {
+  name = "%s"
+  network_id = "${ecl_network_network_v2.network_1.id}"
+  fixed_ip {
+    subnet_id = "${ecl_network_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.12"
+  }
+  tags = {
+    role = "db"
+  }
+}
+
+data "ecl_network_ports_v2" "ports_1" {
+  network_id = "${ecl_network_network_v2.network_1.id}"
+  tags = {
+    role = "web"
+  }
+  depends_on = ["ecl_network_port_v2.port_1", "ecl_network_port_v2.port_2"]
+}
+`, name, name, name, name)
+}
@@ -0,0 +1,74 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/subnets"
+)
+
+func dataSourceNetworkSubnetFreeIPsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkSubnetFreeIPsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ip_count": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"exclude": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed values
+			"ip_addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkSubnetFreeIPsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	subnetID := d.Get("subnet_id").(string)
+	subnet, err := subnets.Get(networkClient, subnetID).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve subnet %s: %s", subnetID, err)
+	}
+
+	var excluded []string
+	for _, v := range d.Get("exclude").(*schema.Set).List() {
+		excluded = append(excluded, v.(string))
+	}
+
+	ipAddresses, err := networkSubnetV2AllocateIPs(networkClient, subnet, excluded, d.Get("ip_count").(int))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Retrieved free IP addresses of subnet %s: %v", subnetID, ipAddresses)
+
+	d.SetId(fmt.Sprintf("%s-%d", subnetID, hashcode.String(strings.Join(ipAddresses, ","))))
+	d.Set("ip_addresses", ipAddresses)
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedNetworkV2SubnetFreeIPsDataSource_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "subnets", "/v2.0/subnets/9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01", testMockNetworkV2SubnetFreeIPsGetSubnet)
+	mc.Register(t, "ports", "/v2.0/ports", testMockNetworkV2SubnetFreeIPsListPorts)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockNetworkV2SubnetFreeIPsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_subnet_free_ips_v2.free_ips_1", "ip_addresses.#", "4"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnet_free_ips_v2.free_ips_1", "ip_addresses.0", "192.168.1.3"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnet_free_ips_v2.free_ips_1", "ip_addresses.1", "192.168.1.5"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnet_free_ips_v2.free_ips_1", "ip_addresses.2", "192.168.1.7"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnet_free_ips_v2.free_ips_1", "ip_addresses.3", "192.168.1.8"),
+				),
+			},
+		},
+	})
+}
+
+const testMockNetworkV2SubnetFreeIPsDataSourceBasic = `
+data "ecl_network_subnet_free_ips_v2" "free_ips_1" {
+  subnet_id = "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+  ip_count = 4
+  exclude = ["192.168.1.6"]
+}
+`
+
+var testMockNetworkV2SubnetFreeIPsGetSubnet = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "subnet": {
+            "allocation_pools": [
+              {
+                "end": "192.168.1.10",
+                "start": "192.168.1.1"
+              }
+            ],
+            "cidr": "192.168.1.0/24",
+            "description": "",
+            "dns_nameservers": [],
+            "enable_dhcp": true,
+            "gateway_ip": "192.168.1.1",
+            "host_routes": [],
+            "id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01",
+            "ip_version": 4,
+            "name": "subnet_1",
+            "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+            "ntp_servers": [],
+            "status": "ACTIVE",
+            "tags": {},
+            "tenant_id": "%s"
+          }
+        }
+`, OS_TENANT_ID)
+
+var testMockNetworkV2SubnetFreeIPsListPorts = fmt.Sprintf(`
+request:
+    method: GET
+    query:
+        network_id:
+            - 8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01
+response:
+    code: 200
+    body: >
+        {
+          "ports": [
+            {
+              "admin_state_up": true,
+              "allowed_address_pairs": [],
+              "description": "",
+              "device_id": "",
+              "device_owner": "",
+              "fixed_ips": [
+                {
+                  "ip_address": "192.168.1.2",
+                  "subnet_id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+                },
+                {
+                  "ip_address": "10.0.0.3",
+                  "subnet_id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b02"
+                }
+              ],
+              "id": "6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01",
+              "mac_address": "fa:16:3e:00:00:01",
+              "name": "port_1",
+              "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+              "segmentation_id": 0,
+              "segmentation_type": "flat",
+              "status": "ACTIVE",
+              "tags": {},
+              "tenant_id": "%[1]s"
+            },
+            {
+              "admin_state_up": true,
+              "allowed_address_pairs": [],
+              "description": "",
+              "device_id": "",
+              "device_owner": "",
+              "fixed_ips": [
+                {
+                  "ip_address": "192.168.1.4",
+                  "subnet_id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+                }
+              ],
+              "id": "6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c02",
+              "mac_address": "fa:16:3e:00:00:02",
+              "name": "port_2",
+              "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+              "segmentation_id": 0,
+              "segmentation_type": "flat",
+              "status": "ACTIVE",
+              "tags": {},
+              "tenant_id": "%[1]s"
+            }
+          ]
+        }
+`, OS_TENANT_ID)
@@ -0,0 +1,65 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkV2SubnetFreeIPsDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkV2SubnetFreeIPsDataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_subnet_free_ips_v2.free_ips_1", "ip_addresses.#", "3"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnet_free_ips_v2.free_ips_1", "ip_addresses.0", "192.168.199.102"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnet_free_ips_v2.free_ips_1", "ip_addresses.1", "192.168.199.104"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnet_free_ips_v2.free_ips_1", "ip_addresses.2", "192.168.199.105"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkV2SubnetFreeIPsDataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+resource "ecl_network_network_v2" "network_1" {
+  name = "%s"
+}
+
+resource "ecl_network_subnet_v2" "subnet_1" {
+  name = "%s"
+  cidr = "192.168.199.0/24"
+  gateway_ip = "192.168.199.100"
+  network_id = "${ecl_network_network_v2.network_1.id}"
+  allocation_pools {
+    start = "192.168.199.100"
+    end = "192.168.199.200"
+  }
+}
+
+resource "ecl_network_port_v2" "port_1" {
+  name = "%s"
+  network_id = "${ecl_network_network_v2.network_1.id}"
+  fixed_ip {
+    subnet_id = "${ecl_network_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.101"
+  }
+}
+
+data "ecl_network_subnet_free_ips_v2" "free_ips_1" {
+  subnet_id = "${ecl_network_subnet_v2.subnet_1.id}"
+  ip_count = 3
+  exclude = ["192.168.199.103"]
+  depends_on = ["ecl_network_port_v2.port_1"]
+}
+`, name, name, name)
+}
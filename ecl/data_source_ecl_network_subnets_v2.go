@@ -0,0 +1,263 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/networks"
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/subnets"
+)
+
+func dataSourceNetworkSubnetsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkSubnetsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"gateway_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{4, 6}),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"plane": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["tenant_id"],
+			},
+
+			// Computed values
+			"ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnets": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allocation_pools": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"end": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"cidr": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_nameservers": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"enable_dhcp": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"gateway_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host_routes": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination_cidr": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"next_hop": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"ip_version": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ntp_servers": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": &schema.Schema{
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"tenant_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkSubnetsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	listOpts := subnets.ListOpts{
+		CIDR:        d.Get("cidr").(string),
+		Description: d.Get("description").(string),
+		GatewayIP:   d.Get("gateway_ip").(string),
+		ID:          d.Get("subnet_id").(string),
+		IPVersion:   d.Get("ip_version").(int),
+		Name:        d.Get("name").(string),
+		NetworkID:   d.Get("network_id").(string),
+		Status:      d.Get("status").(string),
+		TenantID:    d.Get("tenant_id").(string),
+	}
+
+	pages, err := subnets.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list subnets: %s", err)
+	}
+
+	allSubnets, err := subnets.ExtractSubnets(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve subnets: %s", err)
+	}
+
+	// Subnets have no plane, so they are filtered by the networks of the plane.
+	var planeNetworkIDs map[string]bool
+	if plane := d.Get("plane").(string); plane != "" {
+		planeNetworks, err := dataSourceNetworkNetworksV2List(networkClient, networks.ListOpts{
+			ID:    listOpts.NetworkID,
+			Plane: plane,
+		})
+		if err != nil {
+			return err
+		}
+
+		planeNetworkIDs = make(map[string]bool)
+		for _, n := range planeNetworks {
+			planeNetworkIDs[n.ID] = true
+		}
+	}
+
+	tags := resourceTags(d)
+
+	var refinedSubnets []subnets.Subnet
+	for _, s := range allSubnets {
+		if planeNetworkIDs != nil && !planeNetworkIDs[s.NetworkID] {
+			continue
+		}
+		if !networkV2TagsMatch(s.Tags, tags) {
+			continue
+		}
+		refinedSubnets = append(refinedSubnets, s)
+	}
+
+	log.Printf("[DEBUG] Retrieved Subnets: %+v", refinedSubnets)
+
+	ids := make([]string, len(refinedSubnets))
+	result := make([]map[string]interface{}, len(refinedSubnets))
+	for i, s := range refinedSubnets {
+		ids[i] = s.ID
+		result[i] = map[string]interface{}{
+			"id":               s.ID,
+			"allocation_pools": flattenAllocationPools(s.AllocationPools),
+			"cidr":             s.CIDR,
+			"description":      s.Description,
+			"dns_nameservers":  s.DNSNameservers,
+			"enable_dhcp":      s.EnableDHCP,
+			"gateway_ip":       s.GatewayIP,
+			"host_routes":      flattenHostRoutes(s.HostRoutes),
+			"ip_version":       s.IPVersion,
+			"name":             s.Name,
+			"network_id":       s.NetworkID,
+			"ntp_servers":      s.NTPServers,
+			"status":           s.Status,
+			"tags":             s.Tags,
+			"tenant_id":        s.TenantID,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(ids, ""))))
+	d.Set("ids", ids)
+	if err := d.Set("subnets", result); err != nil {
+		return fmt.Errorf("Unable to set subnets: %s", err)
+	}
+
+	return nil
+}
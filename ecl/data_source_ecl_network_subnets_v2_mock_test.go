@@ -0,0 +1,133 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedNetworkV2SubnetsDataSource_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "networks", "/v2.0/networks", testMockNetworkV2SubnetsListPlaneNetworks)
+	mc.Register(t, "subnets", "/v2.0/subnets", testMockNetworkV2SubnetsList)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockNetworkV2SubnetsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_subnets_v2.subnets_1", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnets_v2.subnets_1", "ids.0", "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnets_v2.subnets_1", "subnets.0.cidr", "192.168.1.0/24"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnets_v2.subnets_1", "subnets.0.allocation_pools.0.start", "192.168.1.100"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnets_v2.subnets_1", "subnets.0.network_id", "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01"),
+				),
+			},
+		},
+	})
+}
+
+const testMockNetworkV2SubnetsDataSourceBasic = `
+data "ecl_network_subnets_v2" "subnets_1" {
+  ip_version = 4
+  plane = "data"
+}
+`
+
+var testMockNetworkV2SubnetsListPlaneNetworks = fmt.Sprintf(`
+request:
+    method: GET
+    query:
+        plane:
+            - data
+response:
+    code: 200
+    body: >
+        {
+          "networks": [
+            {
+              "admin_state_up": true,
+              "description": "",
+              "id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+              "name": "network_1",
+              "plane": "data",
+              "shared": false,
+              "status": "ACTIVE",
+              "subnets": [
+                "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+              ],
+              "tags": {},
+              "tenant_id": "%s"
+            }
+          ]
+        }
+`, OS_TENANT_ID)
+
+var testMockNetworkV2SubnetsList = fmt.Sprintf(`
+request:
+    method: GET
+    query:
+        ip_version:
+            - "4"
+response:
+    code: 200
+    body: >
+        {
+          "subnets": [
+            {
+              "allocation_pools": [
+                {
+                  "end": "192.168.1.200",
+                  "start": "192.168.1.100"
+                }
+              ],
+              "cidr": "192.168.1.0/24",
+              "description": "",
+              "dns_nameservers": [],
+              "enable_dhcp": true,
+              "gateway_ip": "192.168.1.1",
+              "host_routes": [],
+              "id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01",
+              "ip_version": 4,
+              "name": "subnet_1",
+              "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+              "ntp_servers": [],
+              "status": "ACTIVE",
+              "tags": {},
+              "tenant_id": "%[1]s"
+            },
+            {
+              "allocation_pools": [
+                {
+                  "end": "10.0.0.200",
+                  "start": "10.0.0.100"
+                }
+              ],
+              "cidr": "10.0.0.0/24",
+              "description": "",
+              "dns_nameservers": [],
+              "enable_dhcp": true,
+              "gateway_ip": "10.0.0.1",
+              "host_routes": [],
+              "id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b02",
+              "ip_version": 4,
+              "name": "subnet_2",
+              "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a09",
+              "ntp_servers": [],
+              "status": "ACTIVE",
+              "tags": {},
+              "tenant_id": "%[1]s"
+            }
+          ]
+        }
+`, OS_TENANT_ID)
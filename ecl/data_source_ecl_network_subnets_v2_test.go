@@ -0,0 +1,73 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkV2SubnetsDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2SubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkV2SubnetsDataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_subnets_v2.subnets_1", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnets_v2.subnets_1", "subnets.#", "2"),
+					resource.TestCheckResourceAttr("data.ecl_network_subnets_v2.subnets_web", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_network_subnets_v2.subnets_web", "ids.0",
+						"ecl_network_subnet_v2.subnet_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkV2SubnetsDataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+resource "ecl_network_network_v2" "network_1" {
+  name = "%s"
+  plane = "data"
+}
+
+resource "ecl_network_subnet_v2" "subnet_1" {
+  name = "%s"
+  cidr = "192.168.199.0/24"
+  network_id = "${ecl_network_network_v2.network_1.id}"
+  tags = {
+    role = "web"
+  }
+}
+
+resource "ecl_network_subnet_v2" "subnet_2" {
+  name = "%s"
+  cidr = "192.168.198.0/24"
+  network_id = "${ecl_network_network_v2.network_1.id}"
+  tags = {
+    role = "db"
+  }
+}
+
+data "ecl_network_subnets_v2" "subnets_1" {
+  network_id = "${ecl_network_network_v2.network_1.id}"
+  plane = "data"
+  depends_on = ["ecl_network_subnet_v2.subnet_1", "ecl_network_subnet_v2.subnet_2"]
+}
+
+data "ecl_network_subnets_v2" "subnets_web" {
+  network_id = "${ecl_network_network_v2.network_1.id}"
+  tags = {
+    role = "web"
+  }
+  depends_on = ["ecl_network_subnet_v2.subnet_1", "ecl_network_subnet_v2.subnet_2"]
+}
+`, name, name, name)
+}
@@ -0,0 +1,41 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkV2VPNGatewayDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckVPNGateway(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkV2VPNGatewayDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ecl_network_vpn_gateway_v2.vpn_gateway_1", "id", OS_VPN_GW_ID),
+					resource.TestCheckResourceAttr("data.ecl_network_vpn_gateway_v2.vpn_gateway_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.ecl_network_vpn_gateway_v2.vpn_gateway_1", "tenant_id", OS_TENANT_ID),
+					resource.TestCheckResourceAttrSet("data.ecl_network_vpn_gateway_v2.vpn_gateway_1", "vpn_service_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_network_vpn_gateway_v2.vpn_gateway_2", "id",
+						"data.ecl_network_vpn_gateway_v2.vpn_gateway_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccNetworkV2VPNGatewayDataSourceBasic = fmt.Sprintf(`
+data "ecl_network_vpn_gateway_v2" "vpn_gateway_1" {
+	vpn_gateway_id = %q
+}
+
+data "ecl_network_vpn_gateway_v2" "vpn_gateway_2" {
+	name = data.ecl_network_vpn_gateway_v2.vpn_gateway_1.name
+	vpn_service_id = data.ecl_network_vpn_gateway_v2.vpn_gateway_1.vpn_service_id
+}
+`,
+	OS_VPN_GW_ID)
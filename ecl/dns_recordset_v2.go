@@ -0,0 +1,223 @@
+package ecl
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var dnsRecordSetV2LabelRegexp = regexp.MustCompile(`^[A-Za-z0-9_]([A-Za-z0-9_-]{0,61}[A-Za-z0-9_])?$`)
+
+// dnsRecordSetV2Qualify returns name as a fully qualified domain name.
+// A name is taken as relative to the zone unless it has a trailing dot
+// or already ends with the zone name. The case of name is preserved.
+func dnsRecordSetV2Qualify(name, zoneName string) string {
+	name = strings.TrimSpace(name)
+	zone := strings.TrimSuffix(zoneName, ".")
+
+	switch {
+	case name == "@" && zone != "":
+		return zone + "."
+	case strings.HasSuffix(name, "."):
+		return name
+	case zone == "",
+		strings.EqualFold(name, zone),
+		strings.HasSuffix(strings.ToLower(name), "."+strings.ToLower(zone)):
+		return name + "."
+	}
+
+	return name + "." + zone + "."
+}
+
+// dnsRecordSetV2NormalizeRecord returns the record in the form
+// ECL2.0 DNS API returns it: domain names are fully qualified,
+// IPv6 addresses have no [brackets] and TXT strings are quoted.
+func dnsRecordSetV2NormalizeRecord(rrtype, record, zoneName string) string {
+	record = strings.TrimSpace(record)
+	fields := strings.Fields(record)
+
+	switch strings.ToUpper(rrtype) {
+	case "AAAA":
+		return strings.Trim(record, "[]")
+	case "CNAME", "NS", "PTR":
+		return dnsRecordSetV2Qualify(record, zoneName)
+	case "MX":
+		if len(fields) == 2 {
+			return fields[0] + " " + dnsRecordSetV2Qualify(fields[1], zoneName)
+		}
+	case "SRV":
+		if len(fields) == 4 {
+			return strings.Join(fields[:3], " ") + " " + dnsRecordSetV2Qualify(fields[3], zoneName)
+		}
+	case "TXT", "SPF":
+		return dnsRecordSetV2QuoteTXT(record)
+	}
+
+	return record
+}
+
+// dnsRecordSetV2HasDomainName returns whether records of the type contain
+// a domain name, which dnsRecordSetV2NormalizeRecord qualifies with the zone.
+func dnsRecordSetV2HasDomainName(rrtype string) bool {
+	switch strings.ToUpper(rrtype) {
+	case "CNAME", "NS", "PTR", "MX", "SRV":
+		return true
+	}
+	return false
+}
+
+// dnsRecordSetV2QuoteTXT quotes a TXT record, splitting it into strings
+// of 255 characters. Records which are already quoted are kept as is.
+func dnsRecordSetV2QuoteTXT(record string) string {
+	if strings.HasPrefix(record, `"`) {
+		return record
+	}
+
+	var quoted []string
+	for len(record) > 255 {
+		quoted = append(quoted, dnsRecordSetV2EscapeTXT(record[:255]))
+		record = record[255:]
+	}
+	quoted = append(quoted, dnsRecordSetV2EscapeTXT(record))
+
+	return strings.Join(quoted, " ")
+}
+
+func dnsRecordSetV2EscapeTXT(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	return `"` + s + `"`
+}
+
+// dnsRecordSetV2ValidateRecord checks the data of a record of the given type.
+func dnsRecordSetV2ValidateRecord(rrtype, record string) error {
+	record = strings.TrimSpace(record)
+	fields := strings.Fields(record)
+
+	switch strings.ToUpper(rrtype) {
+	case "A":
+		ip := net.ParseIP(record)
+		if ip == nil || ip.To4() == nil {
+			return fmt.Errorf("%q is not a valid IPv4 address", record)
+		}
+	case "AAAA":
+		ip := net.ParseIP(strings.Trim(record, "[]"))
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("%q is not a valid IPv6 address", record)
+		}
+	case "CNAME", "NS", "PTR":
+		if err := dnsRecordSetV2ValidateHostname(record, false); err != nil {
+			return err
+		}
+	case "MX":
+		if len(fields) != 2 {
+			return fmt.Errorf("%q must be in the form of \"<preference> <exchange>\"", record)
+		}
+		if err := dnsRecordSetV2ValidateUint16(fields[0], "preference"); err != nil {
+			return err
+		}
+		if err := dnsRecordSetV2ValidateHostname(fields[1], false); err != nil {
+			return err
+		}
+	case "SRV":
+		if len(fields) != 4 {
+			return fmt.Errorf("%q must be in the form of \"<priority> <weight> <port> <target>\"", record)
+		}
+		for i, name := range []string{"priority", "weight", "port"} {
+			if err := dnsRecordSetV2ValidateUint16(fields[i], name); err != nil {
+				return err
+			}
+		}
+		if fields[3] != "." {
+			if err := dnsRecordSetV2ValidateHostname(fields[3], false); err != nil {
+				return err
+			}
+		}
+	case "TXT", "SPF":
+		if record == "" {
+			return fmt.Errorf("%s record must not be empty", rrtype)
+		}
+		if strings.HasPrefix(record, `"`) {
+			return dnsRecordSetV2ValidateQuotedTXT(record)
+		}
+	default:
+		return fmt.Errorf("unsupported record type %s", rrtype)
+	}
+
+	return nil
+}
+
+// dnsRecordSetV2ValidateQuotedTXT checks a TXT record consisting of
+// quoted strings of at most 255 characters.
+func dnsRecordSetV2ValidateQuotedTXT(record string) error {
+	rest := record
+	for rest != "" {
+		if !strings.HasPrefix(rest, `"`) {
+			return fmt.Errorf("%q has text outside of the quoted strings", record)
+		}
+
+		length := 0
+		i := 1
+		for ; i < len(rest) && rest[i] != '"'; i++ {
+			if rest[i] == '\\' {
+				i++
+			}
+			length++
+		}
+		if i >= len(rest) {
+			return fmt.Errorf("%q has an unterminated quoted string", record)
+		}
+		if length > 255 {
+			return fmt.Errorf("%q has a string longer than 255 characters", record)
+		}
+
+		rest = strings.TrimLeft(rest[i+1:], " \t")
+	}
+
+	return nil
+}
+
+// dnsRecordSetV2ValidateHostname checks a relative or fully qualified
+// domain name. A leading "*" label is allowed if wildcard is set.
+func dnsRecordSetV2ValidateHostname(name string, wildcard bool) error {
+	if name == "@" {
+		return nil
+	}
+
+	trimmed := strings.TrimSuffix(name, ".")
+	if trimmed == "" {
+		return fmt.Errorf("%q is not a valid domain name", name)
+	}
+	if len(trimmed) > 253 {
+		return fmt.Errorf("%q is longer than 253 characters", name)
+	}
+
+	for i, label := range strings.Split(trimmed, ".") {
+		if wildcard && i == 0 && label == "*" {
+			continue
+		}
+		if !dnsRecordSetV2LabelRegexp.MatchString(label) {
+			return fmt.Errorf("%q is not a valid domain name: invalid label %q", name, label)
+		}
+	}
+
+	return nil
+}
+
+func dnsRecordSetV2ValidateUint16(s, name string) error {
+	if _, err := strconv.ParseUint(s, 10, 16); err != nil {
+		return fmt.Errorf("%s %q must be an integer between 0 and 65535", name, s)
+	}
+	return nil
+}
+
+// validateDNSRecordSetV2Name is a SchemaValidateFunc for the name of
+// a record set.
+func validateDNSRecordSetV2Name(v interface{}, k string) (ws []string, errors []error) {
+	if err := dnsRecordSetV2ValidateHostname(v.(string), true); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
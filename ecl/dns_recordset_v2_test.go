@@ -0,0 +1,102 @@
+package ecl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDNSRecordSetV2ValidateRecord(t *testing.T) {
+	cases := []struct {
+		rrtype string
+		record string
+		valid  bool
+	}{
+		{rrtype: "A", record: "192.0.2.1", valid: true},
+		{rrtype: "A", record: "192.0.2", valid: false},
+		{rrtype: "A", record: "2001:db8::1", valid: false},
+		{rrtype: "AAAA", record: "2001:db8::1", valid: true},
+		{rrtype: "AAAA", record: "[2001:db8::1]", valid: true},
+		{rrtype: "AAAA", record: "192.0.2.1", valid: false},
+		{rrtype: "CNAME", record: "www.example.com.", valid: true},
+		{rrtype: "CNAME", record: "www", valid: true},
+		{rrtype: "CNAME", record: "www..example.com.", valid: false},
+		{rrtype: "CNAME", record: "-www.example.com.", valid: false},
+		{rrtype: "MX", record: "10 mx1.example.com.", valid: true},
+		{rrtype: "MX", record: "mx1.example.com.", valid: false},
+		{rrtype: "MX", record: "70000 mx1.example.com.", valid: false},
+		{rrtype: "SRV", record: "10 60 5060 sip.example.com.", valid: true},
+		{rrtype: "SRV", record: "0 0 0 .", valid: true},
+		{rrtype: "SRV", record: "10 60 sip.example.com.", valid: false},
+		{rrtype: "TXT", record: "v=spf1 -all", valid: true},
+		{rrtype: "TXT", record: `"v=spf1" "-all"`, valid: true},
+		{rrtype: "TXT", record: `"unterminated`, valid: false},
+		{rrtype: "TXT", record: `"quoted" unquoted`, valid: false},
+		{rrtype: "TXT", record: `"` + strings.Repeat("a", 256) + `"`, valid: false},
+		{rrtype: "TXT", record: "", valid: false},
+		{rrtype: "NS", record: "ns1.example.com.", valid: true},
+		{rrtype: "PTR", record: "host.example.com.", valid: true},
+		{rrtype: "HINFO", record: "foo bar", valid: false},
+	}
+
+	for _, c := range cases {
+		err := dnsRecordSetV2ValidateRecord(c.rrtype, c.record)
+		if c.valid && err != nil {
+			t.Fatalf("expected %s %q to be valid, got %s", c.rrtype, c.record, err)
+		}
+		if !c.valid && err == nil {
+			t.Fatalf("expected %s %q to be invalid", c.rrtype, c.record)
+		}
+	}
+}
+
+func TestDNSRecordSetV2NormalizeRecord(t *testing.T) {
+	cases := []struct {
+		rrtype   string
+		record   string
+		expected string
+	}{
+		{rrtype: "A", record: " 192.0.2.1 ", expected: "192.0.2.1"},
+		{rrtype: "AAAA", record: "[2001:db8::1]", expected: "2001:db8::1"},
+		{rrtype: "CNAME", record: "www", expected: "www.example.com."},
+		{rrtype: "CNAME", record: "www.example.com", expected: "www.example.com."},
+		{rrtype: "CNAME", record: "www.example.net.", expected: "www.example.net."},
+		{rrtype: "NS", record: "@", expected: "example.com."},
+		{rrtype: "MX", record: "10  mx1", expected: "10 mx1.example.com."},
+		{rrtype: "SRV", record: "10 60 5060 sip", expected: "10 60 5060 sip.example.com."},
+		{rrtype: "TXT", record: "v=spf1 -all", expected: `"v=spf1 -all"`},
+		{rrtype: "TXT", record: `say "hello"`, expected: `"say \"hello\""`},
+		{rrtype: "TXT", record: `"already quoted"`, expected: `"already quoted"`},
+		{rrtype: "TXT", record: strings.Repeat("a", 300), expected: `"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `"`},
+	}
+
+	for _, c := range cases {
+		v := dnsRecordSetV2NormalizeRecord(c.rrtype, c.record, "example.com.")
+		if v != c.expected {
+			t.Fatalf("expected %s %q to be normalized to %q, got %q", c.rrtype, c.record, c.expected, v)
+		}
+	}
+}
+
+func TestDNSRecordSetV2Qualify(t *testing.T) {
+	cases := []struct {
+		name     string
+		zoneName string
+		expected string
+	}{
+		{name: "www", zoneName: "example.com.", expected: "www.example.com."},
+		{name: "WWW", zoneName: "example.com.", expected: "WWW.example.com."},
+		{name: "www.example.com", zoneName: "example.com.", expected: "www.example.com."},
+		{name: "www.Example.COM", zoneName: "example.com.", expected: "www.Example.COM."},
+		{name: "www.example.com.", zoneName: "example.com.", expected: "www.example.com."},
+		{name: "example.com", zoneName: "example.com.", expected: "example.com."},
+		{name: "@", zoneName: "example.com.", expected: "example.com."},
+		{name: "www", zoneName: "", expected: "www."},
+	}
+
+	for _, c := range cases {
+		v := dnsRecordSetV2Qualify(c.name, c.zoneName)
+		if v != c.expected {
+			t.Fatalf("expected %q to be qualified to %q, got %q", c.name, c.expected, v)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package ecl
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/zones"
+)
+
+const dnsReverseZoneV2Suffix = "in-addr.arpa."
+
+// dnsReverseZoneV2Names returns the names of the in-addr.arpa zones covering
+// an IPv4 block. Reverse zones are delegated by octet, so a block between two
+// octet boundaries is covered by the zones of the next octet, e.g. a /20 by
+// sixteen /24 zones. A block smaller than /24 has an RFC 2317 classless zone
+// of its own, e.g. 16-28.113.0.203.in-addr.arpa. for 203.0.113.16/28, so that
+// blocks of the same /24 do not share a zone.
+// cidr is either an address, as the cidr of ecl_network_public_ip_v2, or
+// a block in CIDR notation.
+func dnsReverseZoneV2Names(cidr string, submaskLength int) ([]string, error) {
+	address := cidr
+	if parts := strings.SplitN(cidr, "/", 2); len(parts) == 2 {
+		length, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid CIDR", cidr)
+		}
+		if submaskLength != 0 && length != submaskLength {
+			return nil, fmt.Errorf("Prefix length of %q does not match submask_length %d", cidr, submaskLength)
+		}
+		address = parts[0]
+		submaskLength = length
+	}
+
+	ip := net.ParseIP(address).To4()
+	if ip == nil {
+		return nil, fmt.Errorf("%q is not a valid IPv4 address", address)
+	}
+	if submaskLength < 8 || submaskLength > 32 {
+		return nil, fmt.Errorf("Submask length must be between 8 and 32, got %d", submaskLength)
+	}
+
+	network := ip.Mask(net.CIDRMask(submaskLength, 32))
+	if submaskLength > 24 {
+		return []string{dnsReverseZoneV2ClasslessName(network, submaskLength)}, nil
+	}
+
+	octets := 3
+	count := 1
+	if submaskLength < 24 {
+		octets = (submaskLength + 7) / 8
+		count = 1 << uint(octets*8-submaskLength)
+	}
+
+	names := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		zone := make(net.IP, 4)
+		copy(zone, network)
+		zone[octets-1] += byte(i)
+		names = append(names, dnsReverseZoneV2Name(zone, octets))
+	}
+
+	return names, nil
+}
+
+// dnsReverseZoneV2RecordName returns the name of the PTR record of an IPv4
+// address in a reverse zone, and the prefix length of the block the zone
+// covers. ok is false when the zone does not contain the address.
+// In a classless zone, the record is named after the last octet of the
+// address within the zone, e.g. 17.16-28.113.0.203.in-addr.arpa.
+func dnsReverseZoneV2RecordName(zoneName string, ip net.IP) (name string, length int, ok bool) {
+	zoneName = strings.ToLower(dnsRecordSetV2Qualify(zoneName, ""))
+
+	labels := strings.SplitN(zoneName, ".", 2)
+	if first, length, classless := dnsReverseZoneV2ParseClassless(labels[0]); classless {
+		last := int(ip[3])
+		if len(labels) != 2 || labels[1] != dnsReverseZoneV2Name(ip, 3) ||
+			last < first || last >= first+1<<uint(32-length) {
+			return "", 0, false
+		}
+		return fmt.Sprintf("%d.%s", last, zoneName), length, true
+	}
+
+	name = dnsReverseZoneV2Name(ip, 4)
+	if name != zoneName && !strings.HasSuffix(name, "."+zoneName) {
+		return "", 0, false
+	}
+
+	octets := strings.Count(strings.TrimSuffix(zoneName, dnsReverseZoneV2Suffix), ".")
+	return name, octets * 8, true
+}
+
+// dnsReverseZoneV2Address returns the IPv4 address of an in-addr.arpa name,
+// either of a /24 zone or of a classless zone.
+func dnsReverseZoneV2Address(name string) (string, error) {
+	trimmed := strings.TrimSuffix(strings.ToLower(dnsRecordSetV2Qualify(name, "")), "."+dnsReverseZoneV2Suffix)
+	labels := strings.Split(trimmed, ".")
+	if len(labels) == 5 {
+		if _, _, classless := dnsReverseZoneV2ParseClassless(labels[1]); classless {
+			labels = append(labels[:1], labels[2:]...)
+		}
+	}
+	if len(labels) != 4 {
+		return "", fmt.Errorf("%q is not an in-addr.arpa name of an IPv4 address", name)
+	}
+
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+
+	address := strings.Join(labels, ".")
+	if net.ParseIP(address).To4() == nil {
+		return "", fmt.Errorf("%q is not an in-addr.arpa name of an IPv4 address", name)
+	}
+
+	return address, nil
+}
+
+// dnsReverseZoneV2ClasslessName returns the RFC 2317 name of the zone of
+// a block smaller than /24. <first address>-<prefix length> is used instead
+// of the <first address>/<prefix length> of the RFC examples, as ECL2.0 DNS
+// API does not accept slashes in zone names.
+func dnsReverseZoneV2ClasslessName(network net.IP, submaskLength int) string {
+	return fmt.Sprintf("%d-%d.%s", network[3], submaskLength, dnsReverseZoneV2Name(network, 3))
+}
+
+// dnsReverseZoneV2ParseClassless parses the first label of a classless zone
+// name, e.g. 16-28.
+func dnsReverseZoneV2ParseClassless(label string) (first, length int, ok bool) {
+	parts := strings.SplitN(label, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+
+	first, err := strconv.Atoi(parts[0])
+	if err != nil || first < 0 || first > 255 {
+		return 0, 0, false
+	}
+	length, err = strconv.Atoi(parts[1])
+	if err != nil || length <= 24 || length > 32 {
+		return 0, 0, false
+	}
+
+	return first, length, true
+}
+
+func dnsReverseZoneV2Name(ip net.IP, octets int) string {
+	labels := make([]string, 0, octets+1)
+	for i := octets - 1; i >= 0; i-- {
+		labels = append(labels, strconv.Itoa(int(ip[i])))
+	}
+	labels = append(labels, dnsReverseZoneV2Suffix)
+
+	return strings.Join(labels, ".")
+}
+
+// dnsReverseZoneV2ListZones returns all zones of the tenant.
+func dnsReverseZoneV2ListZones(client *eclcloud.ServiceClient) ([]zones.Zone, error) {
+	pages, err := zones.List(client, zones.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve zones: %s", err)
+	}
+
+	allZones, err := zones.ExtractZones(pages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to extract zones: %s", err)
+	}
+
+	return allZones, nil
+}
+
+// dnsReverseZoneV2Find returns the most specific zone containing an IPv4
+// address, and the name of the PTR record of the address in the zone.
+func dnsReverseZoneV2Find(client *eclcloud.ServiceClient, ip net.IP) (*zones.Zone, string, error) {
+	allZones, err := dnsReverseZoneV2ListZones(client)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var found *zones.Zone
+	var name string
+	longest := -1
+	for i, zone := range allZones {
+		n, length, ok := dnsReverseZoneV2RecordName(zone.Name, ip)
+		if !ok || length <= longest {
+			continue
+		}
+		found = &allZones[i]
+		name = n
+		longest = length
+	}
+
+	if found == nil {
+		return nil, "", fmt.Errorf("No reverse zone found for %s", ip)
+	}
+
+	return found, name, nil
+}
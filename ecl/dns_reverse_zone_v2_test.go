@@ -0,0 +1,118 @@
+package ecl
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestDNSReverseZoneV2Names(t *testing.T) {
+	cases := []struct {
+		cidr          string
+		submaskLength int
+		expected      []string
+		valid         bool
+	}{
+		{
+			cidr: "203.0.113.16", submaskLength: 28, valid: true,
+			expected: []string{"16-28.113.0.203.in-addr.arpa."},
+		},
+		{
+			cidr: "203.0.113.16/28", valid: true,
+			expected: []string{"16-28.113.0.203.in-addr.arpa."},
+		},
+		{
+			cidr: "203.0.113.130", submaskLength: 25, valid: true,
+			expected: []string{"128-25.113.0.203.in-addr.arpa."},
+		},
+		{
+			cidr: "203.0.113.5", submaskLength: 24, valid: true,
+			expected: []string{"113.0.203.in-addr.arpa."},
+		},
+		{
+			cidr: "203.0.114.0", submaskLength: 23, valid: true,
+			expected: []string{"114.0.203.in-addr.arpa.", "115.0.203.in-addr.arpa."},
+		},
+		{
+			cidr: "198.51.0.0", submaskLength: 16, valid: true,
+			expected: []string{"51.198.in-addr.arpa."},
+		},
+		{cidr: "203.0.113.16/28", submaskLength: 29, valid: false},
+		{cidr: "2001:db8::", submaskLength: 64, valid: false},
+		{cidr: "10.0.0.0", submaskLength: 4, valid: false},
+	}
+
+	for _, c := range cases {
+		names, err := dnsReverseZoneV2Names(c.cidr, c.submaskLength)
+		if c.valid && err != nil {
+			t.Fatalf("expected %s/%d to be valid, got %s", c.cidr, c.submaskLength, err)
+		}
+		if !c.valid && err == nil {
+			t.Fatalf("expected %s/%d to be invalid", c.cidr, c.submaskLength)
+		}
+		if c.valid && !reflect.DeepEqual(names, c.expected) {
+			t.Fatalf("expected %s/%d to be %v, got %v", c.cidr, c.submaskLength, c.expected, names)
+		}
+	}
+
+	names, err := dnsReverseZoneV2Names("10.0.0.0", 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 16 || names[15] != "15.0.10.in-addr.arpa." {
+		t.Fatalf("expected sixteen /24 zones, got %v", names)
+	}
+}
+
+func TestDNSReverseZoneV2RecordName(t *testing.T) {
+	cases := []struct {
+		zoneName string
+		address  string
+		expected string
+		length   int
+		ok       bool
+	}{
+		{
+			zoneName: "113.0.203.in-addr.arpa.", address: "203.0.113.17", ok: true,
+			expected: "17.113.0.203.in-addr.arpa.", length: 24,
+		},
+		{
+			zoneName: "0.203.in-addr.arpa.", address: "203.0.113.17", ok: true,
+			expected: "17.113.0.203.in-addr.arpa.", length: 16,
+		},
+		{
+			zoneName: "16-28.113.0.203.in-addr.arpa.", address: "203.0.113.17", ok: true,
+			expected: "17.16-28.113.0.203.in-addr.arpa.", length: 28,
+		},
+		{zoneName: "16-28.113.0.203.in-addr.arpa.", address: "203.0.113.32", ok: false},
+		{zoneName: "16-28.113.0.203.in-addr.arpa.", address: "203.0.114.17", ok: false},
+		{zoneName: "114.0.203.in-addr.arpa.", address: "203.0.113.17", ok: false},
+	}
+
+	for _, c := range cases {
+		name, length, ok := dnsReverseZoneV2RecordName(c.zoneName, net.ParseIP(c.address).To4())
+		if ok != c.ok {
+			t.Fatalf("expected %s to contain %s: %t, got %t", c.zoneName, c.address, c.ok, ok)
+		}
+		if ok && (name != c.expected || length != c.length) {
+			t.Fatalf("expected %s in %s to be %s (/%d), got %s (/%d)",
+				c.address, c.zoneName, c.expected, c.length, name, length)
+		}
+	}
+}
+
+func TestDNSReverseZoneV2Address(t *testing.T) {
+	for _, name := range []string{"17.113.0.203.in-addr.arpa.", "17.16-28.113.0.203.in-addr.arpa."} {
+		address, err := dnsReverseZoneV2Address(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if address != "203.0.113.17" {
+			t.Fatalf("unexpected address %s of %s", address, name)
+		}
+	}
+
+	if _, err := dnsReverseZoneV2Address("113.0.203.in-addr.arpa."); err == nil {
+		t.Fatal("expected zone name to be invalid")
+	}
+}
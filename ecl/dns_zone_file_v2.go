@@ -0,0 +1,398 @@
+package ecl
+
+import (
+	"bufio"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// dnsZoneFileRecordTypes are the record types which can be managed through a
+// zone file. SOA records are managed by the DNS service itself and are skipped.
+var dnsZoneFileRecordTypes = []string{
+	"A", "AAAA", "MX", "CNAME", "SRV", "SPF", "TXT", "PTR", "NS",
+}
+
+// dnsZoneFileRecordSet represents the records sharing a name and a type.
+type dnsZoneFileRecordSet struct {
+	Name    string
+	Type    string
+	TTL     int
+	Records []string
+}
+
+func (rs dnsZoneFileRecordSet) key() string {
+	return dnsZoneFileKey(rs.Name, rs.Type)
+}
+
+func dnsZoneFileKey(name, rrtype string) string {
+	return strings.ToLower(name) + " " + strings.ToUpper(rrtype)
+}
+
+// parseDNSZoneFile parses a BIND format zone file. Relative names are
+// qualified with origin, and records without a TTL get defaultTTL unless the
+// zone file has a $TTL directive. Since a record set has a single TTL,
+// a record without a TTL joining an existing record set gets its TTL.
+func parseDNSZoneFile(text, origin string, defaultTTL int) ([]dnsZoneFileRecordSet, error) {
+	origin = dnsZoneFileFQDN(origin, "")
+	lines, err := dnsZoneFileLogicalLines(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []dnsZoneFileRecordSet
+	index := make(map[string]int)
+	var owner string
+
+	for _, line := range lines {
+		tokens := line.tokens
+		if len(tokens) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN requires exactly one argument", line.number)
+			}
+			origin = dnsZoneFileFQDN(tokens[1], origin)
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $TTL requires exactly one argument", line.number)
+			}
+			ttl, ok := parseDNSZoneFileTTL(tokens[1])
+			if !ok {
+				return nil, fmt.Errorf("line %d: invalid $TTL %q", line.number, tokens[1])
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: %s is not supported", line.number, tokens[0])
+		}
+
+		if !line.continued {
+			owner = dnsZoneFileFQDN(tokens[0], origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", line.number)
+		}
+
+		ttl := defaultTTL
+		explicitTTL := false
+		for len(tokens) > 0 {
+			if v, ok := parseDNSZoneFileTTL(tokens[0]); ok {
+				ttl = v
+				explicitTTL = true
+				tokens = tokens[1:]
+				continue
+			}
+			if strings.EqualFold(tokens[0], "IN") {
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record requires a type and data", line.number)
+		}
+
+		rrtype := strings.ToUpper(tokens[0])
+		if rrtype == "SOA" {
+			continue
+		}
+		if !dnsZoneFileSupportedType(rrtype) {
+			return nil, fmt.Errorf("line %d: unsupported record type %s", line.number, tokens[0])
+		}
+
+		data, err := dnsZoneFileQualifyData(rrtype, tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line.number, err)
+		}
+
+		k := dnsZoneFileKey(owner, rrtype)
+		i, ok := index[k]
+		if !ok {
+			index[k] = len(result)
+			result = append(result, dnsZoneFileRecordSet{
+				Name: owner,
+				Type: rrtype,
+				TTL:  ttl,
+			})
+			i = len(result) - 1
+		} else if explicitTTL && result[i].TTL != ttl {
+			return nil, fmt.Errorf("line %d: TTL %d of %s %s conflicts with TTL %d of the other records",
+				line.number, ttl, owner, rrtype, result[i].TTL)
+		}
+
+		if !dnsZoneFileContains(result[i].Records, data) {
+			result[i].Records = append(result[i].Records, data)
+		}
+	}
+
+	return result, nil
+}
+
+// renderDNSZoneFile renders record sets as a BIND format zone file.
+func renderDNSZoneFile(origin string, ttl int, rrsets []dnsZoneFileRecordSet) string {
+	sorted := make([]dnsZoneFileRecordSet, len(rrsets))
+	copy(sorted, rrsets)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].key() < sorted[j].key()
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s\n", dnsZoneFileFQDN(origin, ""))
+	fmt.Fprintf(&b, "$TTL %d\n", ttl)
+	for _, rs := range sorted {
+		records := make([]string, len(rs.Records))
+		copy(records, rs.Records)
+		sort.Strings(records)
+		for _, r := range records {
+			fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", rs.Name, rs.TTL, rs.Type, r)
+		}
+	}
+
+	return b.String()
+}
+
+// dnsZoneFileRecordSetEqual reports whether two record sets have the same
+// TTL and records, regardless of the order of the records.
+func dnsZoneFileRecordSetEqual(a, b dnsZoneFileRecordSet) bool {
+	if a.key() != b.key() || a.TTL != b.TTL {
+		return false
+	}
+
+	ar := dnsZoneFileNormalizeRecords(a.Records)
+	br := dnsZoneFileNormalizeRecords(b.Records)
+	if len(ar) != len(br) {
+		return false
+	}
+	for i := range ar {
+		if ar[i] != br[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// dnsZoneFileRecordSetsEqual reports whether two lists of record sets are
+// equal, regardless of their order.
+func dnsZoneFileRecordSetsEqual(a, b []dnsZoneFileRecordSet) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	m := make(map[string]dnsZoneFileRecordSet, len(a))
+	for _, rs := range a {
+		m[rs.key()] = rs
+	}
+	for _, rs := range b {
+		other, ok := m[rs.key()]
+		if !ok || !dnsZoneFileRecordSetEqual(rs, other) {
+			return false
+		}
+	}
+	return true
+}
+
+func dnsZoneFileNormalizeRecords(records []string) []string {
+	raw := make([]interface{}, len(records))
+	for i, r := range records {
+		raw[i] = r
+	}
+	normalized := formatDNSV2Records(raw)
+	sort.Strings(normalized)
+	return normalized
+}
+
+type dnsZoneFileLine struct {
+	number    int
+	continued bool
+	tokens    []string
+}
+
+// dnsZoneFileLogicalLines strips comments, joins parenthesized lines and
+// splits each logical line into tokens. Quoted strings are kept as one token
+// including the quotes.
+func dnsZoneFileLogicalLines(text string) ([]dnsZoneFileLine, error) {
+	var lines []dnsZoneFileLine
+	var current *dnsZoneFileLine
+	var token strings.Builder
+	inQuote := false
+	depth := 0
+
+	flushToken := func() {
+		if token.Len() > 0 {
+			current.tokens = append(current.tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	number := 0
+	for scanner.Scan() {
+		number++
+		physical := scanner.Text()
+
+		if current == nil {
+			current = &dnsZoneFileLine{
+				number:    number,
+				continued: len(physical) > 0 && unicode.IsSpace(rune(physical[0])),
+			}
+		}
+
+		escaped := false
+	chars:
+		for _, c := range physical {
+			switch {
+			case escaped:
+				token.WriteRune(c)
+				escaped = false
+			case c == '\\':
+				token.WriteRune(c)
+				escaped = true
+			case c == '"':
+				token.WriteRune(c)
+				inQuote = !inQuote
+			case inQuote:
+				token.WriteRune(c)
+			case c == ';':
+				break chars
+			case c == '(':
+				flushToken()
+				depth++
+			case c == ')':
+				flushToken()
+				depth--
+				if depth < 0 {
+					return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+				}
+			case unicode.IsSpace(c):
+				flushToken()
+			default:
+				token.WriteRune(c)
+			}
+		}
+
+		if inQuote {
+			return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+		}
+		flushToken()
+
+		if depth == 0 {
+			lines = append(lines, *current)
+			current = nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+
+	return lines, nil
+}
+
+// parseDNSZoneFileTTL parses a TTL given in seconds or in BIND units,
+// e.g. 3600 or 1h.
+func parseDNSZoneFileTTL(s string) (int, bool) {
+	if s == "" || !unicode.IsDigit(rune(s[0])) {
+		return 0, false
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return v, true
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total := 0
+	value := 0
+	hasValue := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			value = value*10 + int(c-'0')
+			hasValue = true
+		default:
+			unit, ok := units[byte(unicode.ToLower(rune(c)))]
+			if !ok || !hasValue {
+				return 0, false
+			}
+			total += value * unit
+			value = 0
+			hasValue = false
+		}
+	}
+	if hasValue {
+		return 0, false
+	}
+
+	return total, true
+}
+
+// dnsZoneFileQualifyData joins the data tokens of a record, qualifying the
+// domain names in it with origin.
+func dnsZoneFileQualifyData(rrtype string, tokens []string, origin string) (string, error) {
+	// position of the domain name in the record data
+	position := -1
+	expected := 0
+	switch rrtype {
+	case "CNAME", "NS", "PTR":
+		position, expected = 0, 1
+	case "MX":
+		position, expected = 1, 2
+	case "SRV":
+		position, expected = 3, 4
+	}
+
+	if expected > 0 && len(tokens) != expected {
+		return "", fmt.Errorf("%s record requires %d fields, got %d", rrtype, expected, len(tokens))
+	}
+
+	data := make([]string, len(tokens))
+	copy(data, tokens)
+	if position >= 0 {
+		data[position] = dnsZoneFileFQDN(data[position], origin)
+	}
+
+	return strings.Join(data, " "), nil
+}
+
+// dnsZoneFileFQDN returns name as a fully qualified, lower case domain name.
+func dnsZoneFileFQDN(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	case origin == "":
+		return name + "."
+	}
+	return name + "." + origin
+}
+
+func dnsZoneFileSupportedType(rrtype string) bool {
+	for _, t := range dnsZoneFileRecordTypes {
+		if t == rrtype {
+			return true
+		}
+	}
+	return false
+}
+
+func dnsZoneFileContains(records []string, record string) bool {
+	for _, r := range records {
+		if r == record {
+			return true
+		}
+	}
+	return false
+}
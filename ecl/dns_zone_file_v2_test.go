@@ -0,0 +1,119 @@
+package ecl
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testDNSZoneFile = `
+$TTL 1h
+@       IN  SOA ns1.example.com. admin.example.com. (
+            2019010101 ; serial
+            3600       ; refresh
+            600        ; retry
+            604800     ; expire
+            300 )      ; minimum
+@           NS    ns1.example.com.
+www     300 IN  A     192.0.2.1
+            IN  A     192.0.2.2
+mail        IN  MX    10 mx1
+txt         IN  TXT   "v=spf1 include:example.net ~all" ; comment
+_sip._tcp   IN  SRV   10 60 5060 sip
+$ORIGIN sub.example.com.
+alias   600     CNAME www.example.com.
+`
+
+func TestParseDNSZoneFile(t *testing.T) {
+	rrsets, err := parseDNSZoneFile(testDNSZoneFile, "example.com", 3600)
+	if err != nil {
+		t.Fatalf("expected zone file to be valid, got %s", err)
+	}
+
+	expected := []dnsZoneFileRecordSet{
+		{Name: "example.com.", Type: "NS", TTL: 3600, Records: []string{"ns1.example.com."}},
+		{Name: "www.example.com.", Type: "A", TTL: 300, Records: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "mail.example.com.", Type: "MX", TTL: 3600, Records: []string{"10 mx1.example.com."}},
+		{Name: "txt.example.com.", Type: "TXT", TTL: 3600, Records: []string{`"v=spf1 include:example.net ~all"`}},
+		{Name: "_sip._tcp.example.com.", Type: "SRV", TTL: 3600, Records: []string{"10 60 5060 sip.example.com."}},
+		{Name: "alias.sub.example.com.", Type: "CNAME", TTL: 600, Records: []string{"www.example.com."}},
+	}
+
+	if !reflect.DeepEqual(rrsets, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, rrsets)
+	}
+}
+
+func TestParseDNSZoneFile_invalid(t *testing.T) {
+	cases := []string{
+		"www IN A",
+		"www IN HINFO foo bar",
+		"www IN MX mx1",
+		"www IN TXT \"unterminated",
+		"www IN A ( 192.0.2.1",
+		"www 300 IN A 192.0.2.1\nwww 600 IN A 192.0.2.2",
+		"$INCLUDE other.zone",
+		"  IN A 192.0.2.1",
+	}
+
+	for _, c := range cases {
+		if _, err := parseDNSZoneFile(c, "example.com.", 3600); err == nil {
+			t.Fatalf("expected %q to be invalid", c)
+		}
+	}
+}
+
+func TestParseDNSZoneFileTTL(t *testing.T) {
+	cases := []struct {
+		ttl      string
+		expected int
+		valid    bool
+	}{
+		{ttl: "3600", expected: 3600, valid: true},
+		{ttl: "1h", expected: 3600, valid: true},
+		{ttl: "1h30m", expected: 5400, valid: true},
+		{ttl: "1W", expected: 604800, valid: true},
+		{ttl: "IN", valid: false},
+		{ttl: "1x", valid: false},
+		{ttl: "1h30", valid: false},
+	}
+
+	for _, c := range cases {
+		v, ok := parseDNSZoneFileTTL(c.ttl)
+		if ok != c.valid {
+			t.Fatalf("expected validity of %q to be %t", c.ttl, c.valid)
+		}
+		if ok && v != c.expected {
+			t.Fatalf("expected %q to be %d, got %d", c.ttl, c.expected, v)
+		}
+	}
+}
+
+func TestRenderDNSZoneFile(t *testing.T) {
+	rrsets, err := parseDNSZoneFile(testDNSZoneFile, "example.com.", 3600)
+	if err != nil {
+		t.Fatalf("expected zone file to be valid, got %s", err)
+	}
+
+	text := renderDNSZoneFile("example.com.", 3600, rrsets)
+	expected := `$ORIGIN example.com.
+$TTL 3600
+_sip._tcp.example.com.	3600	IN	SRV	10 60 5060 sip.example.com.
+alias.sub.example.com.	600	IN	CNAME	www.example.com.
+example.com.	3600	IN	NS	ns1.example.com.
+mail.example.com.	3600	IN	MX	10 mx1.example.com.
+txt.example.com.	3600	IN	TXT	"v=spf1 include:example.net ~all"
+www.example.com.	300	IN	A	192.0.2.1
+www.example.com.	300	IN	A	192.0.2.2
+`
+	if text != expected {
+		t.Fatalf("expected %q, got %q", expected, text)
+	}
+
+	reparsed, err := parseDNSZoneFile(text, "example.com.", 3600)
+	if err != nil {
+		t.Fatalf("expected rendered zone file to be valid, got %s", err)
+	}
+	if !dnsZoneFileRecordSetsEqual(rrsets, reparsed) {
+		t.Fatalf("expected %#v, got %#v", rrsets, reparsed)
+	}
+}
@@ -292,6 +292,34 @@ response:
                         "name": "dedicated-hypervisor",
                         "type": "dedicated-hypervisor"
                     },
+                    {
+                        "endpoints": [
+                            {
+                                "id": "3f6a2a4c-8e1d-4b7a-9c2e-5d0b1f4e6a21",
+                                "interface": "admin",
+                                "region": "%[2]s",
+                                "region_id": "%[2]s",
+                                "url": "%[1]s"
+                            },
+                            {
+                                "id": "3f6a2a4c-8e1d-4b7a-9c2e-5d0b1f4e6a21",
+                                "interface": "internal",
+                                "region": "%[2]s",
+                                "region_id": "%[2]s",
+                                "url": "%[1]s"
+                            },
+                            {
+                                "id": "3f6a2a4c-8e1d-4b7a-9c2e-5d0b1f4e6a21",
+                                "interface": "public",
+                                "region": "%[2]s",
+                                "region_id": "%[2]s",
+                                "url": "%[1]s"
+                            }
+                        ],
+                        "id": "3f6a2a4c-8e1d-4b7a-9c2e-5d0b1f4e6a21",
+                        "name": "dns",
+                        "type": "dns"
+                    },
                     {
                         "endpoints": [
                             {
@@ -347,6 +375,34 @@ response:
                         "id": "097fe34a-ee38-4de2-955b-697639f9210d",
                         "name": "managed-load-balancer",
                         "type": "managed-load-balancer"
+                    },
+                    {
+                        "endpoints": [
+                            {
+                                "id": "6b1f0c2e-4a3d-4e8b-9f7a-2c5d8e1b3a47",
+                                "interface": "admin",
+                                "region": "%[2]s",
+                                "region_id": "%[2]s",
+                                "url": "%[1]s"
+                            },
+                            {
+                                "id": "6b1f0c2e-4a3d-4e8b-9f7a-2c5d8e1b3a47",
+                                "interface": "internal",
+                                "region": "%[2]s",
+                                "region_id": "%[2]s",
+                                "url": "%[1]s"
+                            },
+                            {
+                                "id": "6b1f0c2e-4a3d-4e8b-9f7a-2c5d8e1b3a47",
+                                "interface": "public",
+                                "region": "%[2]s",
+                                "region_id": "%[2]s",
+                                "url": "%[1]s"
+                            }
+                        ],
+                        "id": "6b1f0c2e-4a3d-4e8b-9f7a-2c5d8e1b3a47",
+                        "name": "glance",
+                        "type": "image"
                     }
                 ],
                 "expires_at": "2018-11-28T02:48:52.111201Z",
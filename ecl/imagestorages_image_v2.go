@@ -0,0 +1,479 @@
+package ecl
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/imagestorage/v2/imagedata"
+	"github.com/ulikunitz/xz"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var (
+	imageStoragesImageV2GzipMagic = []byte{0x1f, 0x8b}
+	imageStoragesImageV2XzMagic   = []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}
+)
+
+// imageStoragesImageV2Source is the data of an image to be uploaded.
+// Reading it computes the MD5 checksum of the data, which is compared with
+// the checksum calculated by the image service.
+type imageStoragesImageV2Source struct {
+	io.Reader
+
+	name    string
+	size    int64
+	closers []io.Closer
+
+	md5 hash.Hash
+}
+
+// Close closes the underlying file or HTTP response.
+func (s *imageStoragesImageV2Source) Close() error {
+	var err error
+	for i := len(s.closers) - 1; i >= 0; i-- {
+		if e := s.closers[i].Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// Checksum returns the MD5 checksum of the data read so far.
+func (s *imageStoragesImageV2Source) Checksum() string {
+	return hex.EncodeToString(s.md5.Sum(nil))
+}
+
+// imageStoragesImageV2TempDir is a temporary directory removed on Close.
+type imageStoragesImageV2TempDir string
+
+func (dir imageStoragesImageV2TempDir) Close() error {
+	return os.RemoveAll(string(dir))
+}
+
+// resourceImageStoragesImageV2Source opens the data of the image from either
+// local_file_path or image_source_url.
+func resourceImageStoragesImageV2Source(d *schema.ResourceData, config *Config) (*imageStoragesImageV2Source, error) {
+	if filename := d.Get("local_file_path").(string); filename != "" {
+		return imageStoragesImageV2OpenFile(filename)
+	}
+
+	sourceURL := d.Get("image_source_url").(string)
+	if sourceURL == "" {
+		return nil, fmt.Errorf("Error in config. Either local_file_path or image_source_url must be specified")
+	}
+
+	headers := make(map[string]string)
+	for k, v := range d.Get("image_source_headers").(map[string]interface{}) {
+		headers[k] = v.(string)
+	}
+
+	return imageStoragesImageV2OpenURL(
+		config.httpClient(d.Timeout(schema.TimeoutCreate)),
+		sourceURL, headers,
+		d.Get("image_source_checksum").(string),
+		d.Get("image_cache_path").(string))
+}
+
+func imageStoragesImageV2OpenFile(filename string) (*imageStoragesImageV2Source, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening file %q: %s", filename, err)
+	}
+
+	fstat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("Error reading image file %q: %s", filename, err)
+	}
+
+	s := &imageStoragesImageV2Source{
+		name:    filename,
+		size:    fstat.Size(),
+		closers: []io.Closer{file},
+		md5:     md5.New(),
+	}
+	s.Reader = io.TeeReader(file, s.md5)
+
+	return s, nil
+}
+
+// imageStoragesImageV2OpenURL opens the image at sourceURL. If cacheDir is
+// set, the image is downloaded to the directory first, and the downloaded
+// file is reused as long as it matches checksum. Otherwise the image is
+// downloaded to a temporary directory if checksum is set, so that it is
+// verified before it is uploaded, and is streamed from the server as it is
+// uploaded if not. gzip and xz compressed images are decompressed.
+func imageStoragesImageV2OpenURL(client *http.Client, sourceURL string, headers map[string]string, checksum, cacheDir string) (*imageStoragesImageV2Source, error) {
+	s := &imageStoragesImageV2Source{
+		name: sourceURL,
+		size: -1,
+		md5:  md5.New(),
+	}
+
+	if cacheDir == "" && checksum != "" {
+		tmpDir, err := ioutil.TempDir("", "ecl-image-")
+		if err != nil {
+			return nil, fmt.Errorf("Error creating temporary directory for %s: %s", sourceURL, err)
+		}
+		s.closers = append(s.closers, imageStoragesImageV2TempDir(tmpDir))
+		cacheDir = tmpDir
+	}
+
+	var raw io.Reader
+	if cacheDir != "" {
+		filename, err := imageStoragesImageV2CacheFile(client, sourceURL, headers, checksum, cacheDir)
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+
+		file, err := os.Open(filename)
+		if err != nil {
+			s.Close()
+			return nil, fmt.Errorf("Error opening cached image %q: %s", filename, err)
+		}
+		s.closers = append(s.closers, file)
+		if fstat, err := file.Stat(); err == nil {
+			s.size = fstat.Size()
+		}
+		raw = file
+	} else {
+		resp, err := imageStoragesImageV2Get(client, sourceURL, headers)
+		if err != nil {
+			return nil, err
+		}
+		s.closers = append(s.closers, resp.Body)
+		s.size = resp.ContentLength
+		raw = resp.Body
+	}
+
+	data, compressed, err := imageStoragesImageV2Decompress(raw)
+	if err != nil {
+		s.Close()
+		return nil, fmt.Errorf("Error decompressing %s: %s", sourceURL, err)
+	}
+	if compressed {
+		s.size = -1
+	}
+	if c, ok := data.(io.Closer); ok {
+		s.closers = append(s.closers, c)
+	}
+	s.Reader = io.TeeReader(data, s.md5)
+
+	return s, nil
+}
+
+// imageStoragesImageV2CacheFile returns the path of the image at sourceURL
+// in cacheDir, downloading it unless it is already there.
+func imageStoragesImageV2CacheFile(client *http.Client, sourceURL string, headers map[string]string, checksum, cacheDir string) (string, error) {
+	if err := os.MkdirAll(cacheDir, 0700); err != nil {
+		return "", fmt.Errorf("Error creating image cache directory %q: %s", cacheDir, err)
+	}
+
+	key := sha256.Sum256([]byte(sourceURL))
+	filename := filepath.Join(cacheDir, hex.EncodeToString(key[:]))
+
+	if _, err := os.Stat(filename); err == nil {
+		if checksum == "" {
+			log.Printf("[DEBUG] Using cached image %s of %s", filename, sourceURL)
+			return filename, nil
+		}
+
+		err := imageStoragesImageV2VerifyFile(filename, checksum)
+		if err == nil {
+			log.Printf("[DEBUG] Using cached image %s of %s", filename, sourceURL)
+			return filename, nil
+		}
+		log.Printf("[DEBUG] Downloading %s again: %s", sourceURL, err)
+	}
+
+	resp, err := imageStoragesImageV2Get(client, sourceURL, headers)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	tmp, err := ioutil.TempFile(cacheDir, filepath.Base(filename)+".")
+	if err != nil {
+		return "", fmt.Errorf("Error creating file in image cache directory %q: %s", cacheDir, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	log.Printf("[DEBUG] Downloading %s to %s", sourceURL, tmp.Name())
+
+	var w io.Writer = tmp
+	var sourceHash hash.Hash
+	if checksum != "" {
+		// checksum is validated by the schema.
+		sourceHash, _ = imageStoragesImageV2ChecksumHash(checksum)
+		w = io.MultiWriter(tmp, sourceHash)
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", fmt.Errorf("Error downloading %s: %s", sourceURL, err)
+	}
+
+	if sourceHash != nil {
+		if err := imageStoragesImageV2CompareChecksum(sourceURL, sourceHash, checksum); err != nil {
+			return "", err
+		}
+	}
+
+	if err := os.Rename(tmp.Name(), filename); err != nil {
+		return "", fmt.Errorf("Error saving %s to image cache: %s", sourceURL, err)
+	}
+
+	return filename, nil
+}
+
+func imageStoragesImageV2Get(client *http.Client, sourceURL string, headers map[string]string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", sourceURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating request for %s: %s", sourceURL, err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error downloading %s: %s", sourceURL, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("Error downloading %s: %s", sourceURL, resp.Status)
+	}
+
+	return resp, nil
+}
+
+// imageStoragesImageV2Decompress returns a reader of the decompressed data
+// of r if r is gzip or xz compressed, and r itself otherwise.
+func imageStoragesImageV2Decompress(r io.Reader) (io.Reader, bool, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(imageStoragesImageV2XzMagic))
+	if err != nil && err != io.EOF {
+		return nil, false, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, imageStoragesImageV2GzipMagic):
+		log.Printf("[DEBUG] Decompressing gzip compressed image")
+		gr, err := gzip.NewReader(br)
+		return gr, true, err
+	case bytes.HasPrefix(magic, imageStoragesImageV2XzMagic):
+		log.Printf("[DEBUG] Decompressing xz compressed image")
+		xr, err := xz.NewReader(br)
+		return xr, true, err
+	}
+
+	return br, false, nil
+}
+
+func imageStoragesImageV2VerifyFile(filename, checksum string) error {
+	h, err := imageStoragesImageV2ChecksumHash(checksum)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(h, file); err != nil {
+		return err
+	}
+
+	return imageStoragesImageV2CompareChecksum(filename, h, checksum)
+}
+
+func imageStoragesImageV2CompareChecksum(name string, h hash.Hash, checksum string) error {
+	_, expected := imageStoragesImageV2SplitChecksum(checksum)
+	actual := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("Error wrong checksum of %s: got %q, expected %q", name, actual, expected)
+	}
+	return nil
+}
+
+// imageStoragesImageV2SplitChecksum splits a checksum in the form of
+// "<algorithm>:<hex digest>". If the algorithm is omitted, it is guessed
+// from the length of the digest.
+func imageStoragesImageV2SplitChecksum(checksum string) (string, string) {
+	if parts := strings.SplitN(checksum, ":", 2); len(parts) == 2 {
+		return strings.ToLower(parts[0]), parts[1]
+	}
+
+	switch len(checksum) {
+	case md5.Size * 2:
+		return "md5", checksum
+	case sha1.Size * 2:
+		return "sha1", checksum
+	case sha256.Size * 2:
+		return "sha256", checksum
+	case sha512.Size * 2:
+		return "sha512", checksum
+	}
+
+	return "", checksum
+}
+
+func imageStoragesImageV2ChecksumHash(checksum string) (hash.Hash, error) {
+	algorithm, digest := imageStoragesImageV2SplitChecksum(checksum)
+
+	var h hash.Hash
+	switch algorithm {
+	case "md5":
+		h = md5.New()
+	case "sha1":
+		h = sha1.New()
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+
+	if _, err := hex.DecodeString(digest); err != nil || len(digest) != h.Size()*2 {
+		return nil, fmt.Errorf("%q is not a valid %s digest", digest, algorithm)
+	}
+
+	return h, nil
+}
+
+func validateImageStoragesImageV2SourceChecksum(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := imageStoragesImageV2ChecksumHash(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+func validateImageStoragesImageV2SourceURL(v interface{}, k string) (ws []string, errors []error) {
+	u, err := url.Parse(v.(string))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errors = append(errors, fmt.Errorf("%q must be an http or https URL, got %q", k, v.(string)))
+	}
+	return
+}
+
+// imageStoragesImageV2Download downloads the data of an image to filename.
+// The data is written to a ".part" file named after the checksum of the image
+// first, so that an interrupted download is resumed from where it stopped, and
+// the file is renamed to filename once the checksum is verified.
+func imageStoragesImageV2Download(client *eclcloud.ServiceClient, imageID, checksum string, size int64, filename string, verify bool) error {
+	partName := filename + ".part"
+	if checksum != "" {
+		partName = fmt.Sprintf("%s.%s.part", filename, checksum)
+	}
+
+	part, err := os.OpenFile(partName, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("Error opening %q: %s", partName, err)
+	}
+	defer part.Close()
+
+	h := md5.New()
+	offset, err := io.Copy(h, part)
+	if err != nil {
+		return fmt.Errorf("Error reading %q: %s", partName, err)
+	}
+
+	if offset > size && size > 0 {
+		log.Printf("[DEBUG] %s is larger than image %s, downloading it again", partName, imageID)
+		offset = 0
+	}
+
+	if offset < size || size <= 0 {
+		body, resumed, err := imageStoragesImageV2DownloadFrom(client, imageID, offset)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+
+		if resumed {
+			log.Printf("[DEBUG] Resuming download of image %s from %d bytes", imageID, offset)
+		} else if offset > 0 {
+			offset = 0
+		}
+
+		if offset == 0 {
+			h.Reset()
+			if err := part.Truncate(0); err != nil {
+				return fmt.Errorf("Error truncating %q: %s", partName, err)
+			}
+			if _, err := part.Seek(0, io.SeekStart); err != nil {
+				return fmt.Errorf("Error truncating %q: %s", partName, err)
+			}
+		}
+
+		log.Printf("[WARN] Downloading image %s to %s. This can be pretty long.", imageID, filename)
+		if _, err := io.Copy(io.MultiWriter(part, h), body); err != nil {
+			return fmt.Errorf("Error downloading image %s: %s", imageID, err)
+		}
+	}
+
+	if err := part.Close(); err != nil {
+		return fmt.Errorf("Error writing %q: %s", partName, err)
+	}
+
+	if actual := hex.EncodeToString(h.Sum(nil)); verify && checksum != "" && actual != checksum {
+		os.Remove(partName)
+		return fmt.Errorf("Error wrong checksum of image %s: got %q, expected %q", imageID, actual, checksum)
+	}
+
+	if err := os.Rename(partName, filename); err != nil {
+		return fmt.Errorf("Error renaming %q to %q: %s", partName, filename, err)
+	}
+
+	return nil
+}
+
+// imageStoragesImageV2DownloadFrom requests the data of an image from offset.
+// It reports whether the server returned only the data from offset.
+func imageStoragesImageV2DownloadFrom(client *eclcloud.ServiceClient, imageID string, offset int64) (io.ReadCloser, bool, error) {
+	if offset == 0 {
+		r, err := imagedata.Download(client, imageID).Extract()
+		if err != nil {
+			return nil, false, fmt.Errorf("Error downloading image %s: %s", imageID, err)
+		}
+		if rc, ok := r.(io.ReadCloser); ok {
+			return rc, false, nil
+		}
+		return ioutil.NopCloser(r), false, nil
+	}
+
+	resp, err := client.Get(client.ServiceURL("images", imageID, "file"), nil, &eclcloud.RequestOpts{
+		MoreHeaders: map[string]string{"Range": fmt.Sprintf("bytes=%d-", offset)},
+		OkCodes:     []int{200, 206},
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("Error downloading image %s: %s", imageID, err)
+	}
+
+	return resp.Body, resp.StatusCode == http.StatusPartialContent, nil
+}
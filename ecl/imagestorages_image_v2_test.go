@@ -0,0 +1,216 @@
+package ecl
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ulikunitz/xz"
+)
+
+var testImageStoragesImageV2Content = bytes.Repeat([]byte("DummyContent\n"), 1024)
+
+func testImageStoragesImageV2Gzip(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func testImageStoragesImageV2Xz(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w, err := xz.NewWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func testImageStoragesImageV2Digest(data []byte) (string, string) {
+	m := md5.Sum(data)
+	s := sha256.Sum256(data)
+	return hex.EncodeToString(m[:]), hex.EncodeToString(s[:])
+}
+
+func TestImageStoragesImageV2OpenURL(t *testing.T) {
+	sources := map[string][]byte{
+		"/plain":  testImageStoragesImageV2Content,
+		"/gzip":   testImageStoragesImageV2Gzip(t, testImageStoragesImageV2Content),
+		"/xz":     testImageStoragesImageV2Xz(t, testImageStoragesImageV2Content),
+		"/secret": testImageStoragesImageV2Content,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/secret" && r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		data, ok := sources[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	expectedMD5, _ := testImageStoragesImageV2Digest(testImageStoragesImageV2Content)
+	headers := map[string]string{"Authorization": "Bearer token"}
+	client := (&Config{}).httpClient(time.Minute)
+
+	for path, data := range sources {
+		_, sourceSHA256 := testImageStoragesImageV2Digest(data)
+
+		src, err := imageStoragesImageV2OpenURL(client, server.URL+path, headers, "sha256:"+sourceSHA256, "")
+		if err != nil {
+			t.Fatalf("%s: %s", path, err)
+		}
+
+		actual, err := ioutil.ReadAll(src)
+		if err != nil {
+			t.Fatalf("%s: %s", path, err)
+		}
+		if !bytes.Equal(actual, testImageStoragesImageV2Content) {
+			t.Errorf("%s: unexpected content of %d bytes", path, len(actual))
+		}
+		if src.Checksum() != expectedMD5 {
+			t.Errorf("%s: expected MD5 %s, got %s", path, expectedMD5, src.Checksum())
+		}
+		src.Close()
+	}
+
+	if _, err := imageStoragesImageV2OpenURL(client, server.URL+"/gzip", nil, expectedMD5, ""); err == nil {
+		t.Errorf("expected checksum of the compressed data to be verified")
+	}
+
+	for _, path := range []string{"/secret", "/missing"} {
+		if _, err := imageStoragesImageV2OpenURL(client, server.URL+path, nil, "", ""); err == nil {
+			t.Errorf("%s: expected an error", path)
+		}
+	}
+}
+
+func TestImageStoragesImageV2OpenURL_cache(t *testing.T) {
+	data := testImageStoragesImageV2Gzip(t, testImageStoragesImageV2Content)
+	_, checksum := testImageStoragesImageV2Digest(data)
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	cacheDir, err := ioutil.TempDir("", "image_cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cacheDir)
+
+	client := (&Config{}).httpClient(time.Minute)
+	for i := 0; i < 2; i++ {
+		src, err := imageStoragesImageV2OpenURL(client, server.URL, nil, checksum, cacheDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual, err := ioutil.ReadAll(src)
+		src.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(actual, testImageStoragesImageV2Content) {
+			t.Errorf("unexpected content of %d bytes", len(actual))
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("expected the image to be downloaded once, got %d", requests)
+	}
+
+	_, wrong := testImageStoragesImageV2Digest(testImageStoragesImageV2Content)
+	if _, err := imageStoragesImageV2OpenURL(client, server.URL, nil, wrong, cacheDir); err == nil {
+		t.Errorf("expected an error of a wrong checksum")
+	}
+	if requests != 2 {
+		t.Errorf("expected the image to be downloaded again, got %d requests", requests)
+	}
+}
+
+func TestImageStoragesImageV2OpenURL_tls(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(testImageStoragesImageV2Content)
+	}))
+	defer server.Close()
+
+	if _, err := imageStoragesImageV2OpenURL((&Config{}).httpClient(time.Minute), server.URL, nil, "", ""); err == nil {
+		t.Errorf("expected an error of an unknown certificate authority")
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	config := &Config{tlsConfig: &tls.Config{RootCAs: pool}}
+
+	src, err := imageStoragesImageV2OpenURL(config.httpClient(time.Minute), server.URL, nil, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer src.Close()
+
+	actual, err := ioutil.ReadAll(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(actual, testImageStoragesImageV2Content) {
+		t.Errorf("unexpected content of %d bytes", len(actual))
+	}
+}
+
+func TestImageStoragesImageV2ChecksumHash(t *testing.T) {
+	md5Digest, sha256Digest := testImageStoragesImageV2Digest(testImageStoragesImageV2Content)
+
+	valid := []string{
+		md5Digest,
+		sha256Digest,
+		"md5:" + md5Digest,
+		"SHA256:" + sha256Digest,
+	}
+	for _, checksum := range valid {
+		if _, err := imageStoragesImageV2ChecksumHash(checksum); err != nil {
+			t.Errorf("%s: %s", checksum, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"abc",
+		"sha256:" + md5Digest,
+		"crc32:0123abcd",
+		"md5:" + md5Digest[:31] + "z",
+	}
+	for _, checksum := range invalid {
+		if _, err := imageStoragesImageV2ChecksumHash(checksum); err == nil {
+			t.Errorf("%s: expected an error", checksum)
+		}
+	}
+}
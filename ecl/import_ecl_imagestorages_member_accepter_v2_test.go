@@ -0,0 +1,38 @@
+package ecl
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccImageStoragesV2MemberAccepterImport_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	resourceName := "ecl_imagestorages_member_accepter_v2.accepter_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckImageMemberAccepter(t)
+			createTemporalImage(localFileForDataSourceTest)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImageStoragesV2MemberAccepterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImageStoragesV2MemberAccepterBasic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"region",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,404 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/health_monitors"
+	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/listeners"
+	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/load_balancers"
+	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/policies"
+	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/routes"
+	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/rules"
+	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/target_groups"
+)
+
+// mlbAutoApplyConfigurationsDelay is how long staged configurations of a
+// load balancer are collected before they are applied. Every change of the
+// load balancer within the delay restarts it, so that resources created in
+// parallel are applied at once.
+var mlbAutoApplyConfigurationsDelay = 10 * time.Second
+
+// mlbAutoApplyConfigurationsTimeout is the timeout of an apply of staged
+// configurations, the default timeout of ecl_mlb_load_balancer_action_v1.
+const mlbAutoApplyConfigurationsTimeout = 1 * time.Hour
+
+// mlbConfigurationsApplierV1 applies staged configurations once per load
+// balancer for all the changes made within the delay.
+type mlbConfigurationsApplierV1 struct {
+	mu      sync.Mutex
+	delay   time.Duration
+	batches map[string]*mlbConfigurationsBatchV1
+}
+
+// mlbConfigurationsBatchV1 is a pending apply of a load balancer. failure is
+// the first error of a change in the batch. done is closed when the apply
+// finished with err.
+type mlbConfigurationsBatchV1 struct {
+	timer   *time.Timer
+	failure error
+	done    chan struct{}
+	err     error
+}
+
+func newMLBConfigurationsApplierV1(delay time.Duration) *mlbConfigurationsApplierV1 {
+	return &mlbConfigurationsApplierV1{
+		delay:   delay,
+		batches: make(map[string]*mlbConfigurationsBatchV1),
+	}
+}
+
+// Apply joins the pending apply of the load balancer, or starts one with
+// apply, and blocks until it finished. A change which failed joins with its
+// error as failure, and apply is called with the first failure of the batch.
+// Applies of a load balancer never run concurrently.
+func (a *mlbConfigurationsApplierV1) Apply(loadBalancerID string, failure error, apply func(failure error) error) error {
+	a.mu.Lock()
+	batch, ok := a.batches[loadBalancerID]
+	if ok {
+		batch.timer.Reset(a.delay)
+	} else {
+		batch = &mlbConfigurationsBatchV1{done: make(chan struct{})}
+		batch.timer = time.AfterFunc(a.delay, func() {
+			a.run(loadBalancerID, batch, apply)
+		})
+		a.batches[loadBalancerID] = batch
+	}
+	if batch.failure == nil {
+		batch.failure = failure
+	}
+	a.mu.Unlock()
+
+	<-batch.done
+	return batch.err
+}
+
+func (a *mlbConfigurationsApplierV1) run(loadBalancerID string, batch *mlbConfigurationsBatchV1, apply func(failure error) error) {
+	a.mu.Lock()
+	if a.batches[loadBalancerID] != batch {
+		// The timer was reset after it had fired, and the batch is already
+		// being applied.
+		a.mu.Unlock()
+		return
+	}
+	delete(a.batches, loadBalancerID)
+	failure := batch.failure
+	a.mu.Unlock()
+
+	osMutexKV.Lock(loadBalancerID)
+	defer osMutexKV.Unlock(loadBalancerID)
+
+	batch.err = apply(failure)
+	close(batch.done)
+}
+
+// mlbStageConfigurations calls stage, which stages configurations of the
+// load balancer, while no staged configurations of the load balancer are
+// applied by mlbAutoApplyConfigurations. The lock is released before stage
+// returns, so that the configurations can be applied afterwards.
+func (c *Config) mlbStageConfigurations(loadBalancerID string, stage func() error) error {
+	if !c.MLBAutoApplyConfigurations {
+		return stage()
+	}
+
+	osMutexKV.Lock(loadBalancerID)
+	defer osMutexKV.Unlock(loadBalancerID)
+
+	return stage()
+}
+
+// mlbAutoApplyConfigurations applies the staged configurations of the load
+// balancer when mlb_auto_apply_configurations of the provider is set.
+func (c *Config) mlbAutoApplyConfigurations(client *eclcloud.ServiceClient, loadBalancerID string) error {
+	if !c.MLBAutoApplyConfigurations {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting for staged configurations of ECL managed load balancer load balancer (%s) to be applied", loadBalancerID)
+
+	return c.mlbConfigurationsApplier.Apply(loadBalancerID, nil, c.mlbAutoApplyConfigurationsFunc(client, loadBalancerID))
+}
+
+// mlbAutoApplyConfigurationsFailed returns err of a change of the load
+// balancer which failed. When mlb_cancel_configurations_on_failure of the
+// provider is also set, the staged configurations of the load balancer are
+// cancelled instead of applied, and the cancelled objects are reported.
+func (c *Config) mlbAutoApplyConfigurationsFailed(client *eclcloud.ServiceClient, loadBalancerID string, err error) error {
+	if !c.MLBAutoApplyConfigurations || !c.MLBCancelConfigurationsOnFailure {
+		return err
+	}
+
+	log.Printf("[DEBUG] Waiting for staged configurations of ECL managed load balancer load balancer (%s) to be cancelled", loadBalancerID)
+
+	return c.mlbConfigurationsApplier.Apply(loadBalancerID, err, c.mlbAutoApplyConfigurationsFunc(client, loadBalancerID))
+}
+
+func (c *Config) mlbAutoApplyConfigurationsFunc(client *eclcloud.ServiceClient, loadBalancerID string) func(error) error {
+	return func(failure error) error {
+		if failure == nil {
+			err := mlbLoadBalancerV1ApplyConfigurations(client, loadBalancerID, mlbAutoApplyConfigurationsTimeout)
+			if err == nil || !c.MLBCancelConfigurationsOnFailure {
+				return err
+			}
+			failure = err
+		}
+
+		return mlbLoadBalancerV1CancelConfigurations(client, loadBalancerID, failure)
+	}
+}
+
+// mlbLoadBalancerV1ApplyConfigurations applies the staged configurations of
+// the load balancer and its related resources, if any, and waits for the
+// operation to become COMPLETE.
+func mlbLoadBalancerV1ApplyConfigurations(client *eclcloud.ServiceClient, loadBalancerID string, timeout time.Duration) error {
+	isApplyConfigurationsRequired, err := resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(client, loadBalancerID)
+	if err != nil {
+		return err
+	}
+
+	if !isApplyConfigurationsRequired {
+		log.Printf("[DEBUG] No staged configurations of ECL managed load balancer load balancer (%s) to apply", loadBalancerID)
+		return nil
+	}
+
+	actionOpts := load_balancers.ActionOpts{ApplyConfigurations: true}
+
+	log.Printf("[DEBUG] Applying staged configurations of ECL managed load balancer load balancer (%s)", loadBalancerID)
+
+	err = load_balancers.Action(client, loadBalancerID, actionOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error applying staged configurations of ECL managed load balancer load balancer (%s): %s", loadBalancerID, err)
+	}
+
+	stateChangeConf := &resource.StateChangeConf{
+		Pending:      []string{"PROCESSING"},
+		Target:       []string{"COMPLETE"},
+		Refresh:      resourceMLBLoadBalancerActionV1WaitForComplete(client, loadBalancerID),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 30 * time.Second,
+		MinTimeout:   10 * time.Second,
+	}
+
+	_, err = stateChangeConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for ECL managed load balancer load balancer (%s) to become COMPLETE: %s", loadBalancerID, err)
+	}
+
+	return nil
+}
+
+// mlbStagedObjectV1 is a load balancer or a related resource which has
+// staged configurations.
+type mlbStagedObjectV1 struct {
+	kind                string
+	id                  string
+	configurationStatus string
+	cancelStaged        func() error
+}
+
+func (o mlbStagedObjectV1) String() string {
+	return fmt.Sprintf("%s %s (%s)", o.kind, o.id, o.configurationStatus)
+}
+
+// mlbLoadBalancerV1StagedObjects returns the load balancer and its related
+// resources which have staged configurations. Dependent resources come
+// before the resources they refer to, and the load balancer comes last.
+func mlbLoadBalancerV1StagedObjects(client *eclcloud.ServiceClient, loadBalancerID string) ([]mlbStagedObjectV1, error) {
+	var staged []mlbStagedObjectV1
+
+	add := func(kind, id, configurationStatus string, cancelStaged func(*eclcloud.ServiceClient, string) error) {
+		if configurationStatus == "ACTIVE" {
+			return
+		}
+		staged = append(staged, mlbStagedObjectV1{
+			kind:                kind,
+			id:                  id,
+			configurationStatus: configurationStatus,
+			cancelStaged:        func() error { return cancelStaged(client, id) },
+		})
+	}
+
+	ruleList, err := resourceMLBLoadBalancerActionV1ListRules(client, loadBalancerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range *ruleList {
+		add("rule", rule.ID, rule.ConfigurationStatus, func(c *eclcloud.ServiceClient, id string) error {
+			return rules.CancelStaged(c, id).ExtractErr()
+		})
+	}
+
+	routeList, err := resourceMLBLoadBalancerActionV1ListRoutes(client, loadBalancerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range *routeList {
+		add("route", route.ID, route.ConfigurationStatus, func(c *eclcloud.ServiceClient, id string) error {
+			return routes.CancelStaged(c, id).ExtractErr()
+		})
+	}
+
+	policyList, err := resourceMLBLoadBalancerActionV1ListPolicies(client, loadBalancerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range *policyList {
+		add("policy", policy.ID, policy.ConfigurationStatus, func(c *eclcloud.ServiceClient, id string) error {
+			return policies.CancelStaged(c, id).ExtractErr()
+		})
+	}
+
+	listenerList, err := resourceMLBLoadBalancerActionV1ListListeners(client, loadBalancerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, listener := range *listenerList {
+		add("listener", listener.ID, listener.ConfigurationStatus, func(c *eclcloud.ServiceClient, id string) error {
+			return listeners.CancelStaged(c, id).ExtractErr()
+		})
+	}
+
+	healthMonitorList, err := resourceMLBLoadBalancerActionV1ListHealthMonitors(client, loadBalancerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, healthMonitor := range *healthMonitorList {
+		add("health monitor", healthMonitor.ID, healthMonitor.ConfigurationStatus, func(c *eclcloud.ServiceClient, id string) error {
+			return health_monitors.CancelStaged(c, id).ExtractErr()
+		})
+	}
+
+	targetGroupList, err := resourceMLBLoadBalancerActionV1ListTargetGroups(client, loadBalancerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, targetGroup := range *targetGroupList {
+		add("target group", targetGroup.ID, targetGroup.ConfigurationStatus, func(c *eclcloud.ServiceClient, id string) error {
+			return target_groups.CancelStaged(c, id).ExtractErr()
+		})
+	}
+
+	loadBalancer, err := resourceMLBLoadBalancerActionV1ShowLoadBalancer(client, loadBalancerID)
+	if err != nil {
+		return nil, err
+	}
+	add("load balancer", loadBalancer.ID, loadBalancer.ConfigurationStatus, func(c *eclcloud.ServiceClient, id string) error {
+		return load_balancers.CancelStaged(c, id).ExtractErr()
+	})
+
+	return staged, nil
+}
+
+// mlbLoadBalancerV1CancelConfigurations cancels the staged configurations of
+// the load balancer and its related resources after failure, and returns
+// failure with the objects which were rolled back. When the configurations
+// cannot be cancelled at once, they are cancelled object by object.
+func mlbLoadBalancerV1CancelConfigurations(client *eclcloud.ServiceClient, loadBalancerID string, failure error) error {
+	staged, err := mlbLoadBalancerV1StagedObjects(client, loadBalancerID)
+	if err != nil {
+		return fmt.Errorf("%s\nUnable to cancel staged configurations of ECL managed load balancer load balancer (%s): %s", failure, loadBalancerID, err)
+	}
+
+	if len(staged) == 0 {
+		return fmt.Errorf("%s\nNo staged configurations of ECL managed load balancer load balancer (%s) to cancel", failure, loadBalancerID)
+	}
+
+	log.Printf("[DEBUG] Cancelling staged configurations of ECL managed load balancer load balancer (%s): %v", loadBalancerID, staged)
+
+	var cancelled, notCancelled []string
+	err = load_balancers.CancelConfigurations(client, loadBalancerID).ExtractErr()
+	if err == nil {
+		for _, o := range staged {
+			cancelled = append(cancelled, o.String())
+		}
+	} else {
+		log.Printf("[DEBUG] Unable to cancel staged configurations of ECL managed load balancer load balancer (%s) at once: %s", loadBalancerID, err)
+
+		for _, o := range staged {
+			if err := o.cancelStaged(); err != nil {
+				notCancelled = append(notCancelled, fmt.Sprintf("%s: %s", o, err))
+				continue
+			}
+			cancelled = append(cancelled, o.String())
+		}
+	}
+
+	msg := fmt.Sprintf("%s\nCancelled staged configurations of ECL managed load balancer load balancer (%s)", failure, loadBalancerID)
+	if len(cancelled) > 0 {
+		msg += fmt.Sprintf("\n  rolled back:\n    %s", strings.Join(cancelled, "\n    "))
+	}
+	if len(notCancelled) > 0 {
+		msg += fmt.Sprintf("\n  not rolled back:\n    %s", strings.Join(notCancelled, "\n    "))
+	}
+
+	return fmt.Errorf(msg)
+}
+
+// mlbCurrentConfigurationsSchemaV1 returns the schema of current, which holds
+// the configurations of a resource that are running on the load balancer.
+// Its attributes are computed copies of the attributes of the given keys.
+func mlbCurrentConfigurationsSchemaV1(s map[string]*schema.Schema, keys ...string) *schema.Schema {
+	current := make(map[string]*schema.Schema, len(keys))
+	for _, key := range keys {
+		current[key] = mlbComputedSchemaV1(s[key])
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: current,
+		},
+	}
+}
+
+func mlbComputedSchemaV1(s *schema.Schema) *schema.Schema {
+	computed := &schema.Schema{
+		Type:     s.Type,
+		Computed: true,
+		Elem:     s.Elem,
+	}
+
+	if elem, ok := s.Elem.(*schema.Resource); ok {
+		fields := make(map[string]*schema.Schema, len(elem.Schema))
+		for key, field := range elem.Schema {
+			fields[key] = mlbComputedSchemaV1(field)
+		}
+		computed.Elem = &schema.Resource{Schema: fields}
+	}
+
+	return computed
+}
+
+// resourceMLBV1StagedChangesPending sets staged_changes_pending in the plan
+// when the configurations of the given keys are left staged by the apply, so
+// that an apply with ecl_mlb_load_balancer_action_v1 is shown to be pending.
+func resourceMLBV1StagedChangesPending(keys ...string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		autoApply := false
+		if config, ok := meta.(*Config); ok {
+			autoApply = config.MLBAutoApplyConfigurations
+		}
+
+		if d.Id() == "" {
+			return d.SetNew("staged_changes_pending", !autoApply)
+		}
+
+		for _, key := range keys {
+			if d.HasChange(key) {
+				// The change is staged, or applied together with all the
+				// staged configurations when they are applied automatically.
+				return d.SetNew("staged_changes_pending", !autoApply)
+			}
+		}
+
+		return nil
+	}
+}
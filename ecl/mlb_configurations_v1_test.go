@@ -0,0 +1,126 @@
+package ecl
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMLBConfigurationsApplierV1Apply(t *testing.T) {
+	applier := newMLBConfigurationsApplierV1(100 * time.Millisecond)
+
+	var applied = map[string]*int32{
+		"lb-1": new(int32),
+		"lb-2": new(int32),
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 6)
+	for _, id := range []string{"lb-1", "lb-1", "lb-1", "lb-2", "lb-2", "lb-2"} {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			errs <- applier.Apply(id, nil, func(failure error) error {
+				atomic.AddInt32(applied[id], 1)
+				if id == "lb-2" {
+					return fmt.Errorf("apply failed")
+				}
+				return nil
+			})
+		}(id)
+	}
+	wg.Wait()
+	close(errs)
+
+	var failed int
+	for err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+
+	for id, n := range applied {
+		if *n != 1 {
+			t.Fatalf("%s: expected the configurations to be applied once, got %d", id, *n)
+		}
+	}
+
+	if failed != 3 {
+		t.Fatalf("expected the error of the apply to be returned to 3 callers, got %d", failed)
+	}
+
+	// A change after the apply starts a new one.
+	err := applier.Apply("lb-1", nil, func(failure error) error {
+		atomic.AddInt32(applied["lb-1"], 1)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *applied["lb-1"] != 2 {
+		t.Fatalf("expected the configurations to be applied again, got %d", *applied["lb-1"])
+	}
+}
+
+func TestMLBConfigurationsApplierV1ApplyFailure(t *testing.T) {
+	applier := newMLBConfigurationsApplierV1(100 * time.Millisecond)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 3)
+	for _, failure := range []error{nil, fmt.Errorf("update failed"), nil} {
+		wg.Add(1)
+		go func(failure error) {
+			defer wg.Done()
+			errs <- applier.Apply("lb-1", failure, func(failure error) error {
+				if failure == nil {
+					return nil
+				}
+				return fmt.Errorf("%s: cancelled", failure)
+			})
+		}(failure)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err == nil || err.Error() != "update failed: cancelled" {
+			t.Fatalf("expected the failure of the batch to be returned to every caller, got %v", err)
+		}
+	}
+}
+
+func TestMLBStageConfigurationsWaitsForApply(t *testing.T) {
+	config := &Config{
+		MLBAutoApplyConfigurations: true,
+		mlbConfigurationsApplier:   newMLBConfigurationsApplierV1(10 * time.Millisecond),
+	}
+
+	var applying int32
+	started := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- config.mlbConfigurationsApplier.Apply("lb-1", nil, func(failure error) error {
+			atomic.StoreInt32(&applying, 1)
+			close(started)
+			time.Sleep(100 * time.Millisecond)
+			atomic.StoreInt32(&applying, 0)
+			return nil
+		})
+	}()
+	<-started
+
+	err := config.mlbStageConfigurations("lb-1", func() error {
+		if atomic.LoadInt32(&applying) != 0 {
+			return fmt.Errorf("configurations staged while they were applied")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,102 @@
+package ecl
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// networkGatewayInterfaceV2GatewayIDKeys maps the service types of
+// ecl_network_gateway_interface_v2 to the attribute of their gateway.
+var networkGatewayInterfaceV2GatewayIDKeys = map[string]string{
+	"aws":      "aws_gw_id",
+	"azure":    "azure_gw_id",
+	"fic":      "fic_gw_id",
+	"gcp":      "gcp_gw_id",
+	"interdc":  "interdc_gw_id",
+	"internet": "internet_gw_id",
+	"vpn":      "vpn_gw_id",
+}
+
+// networkGatewayInterfaceV2AddressKeys are the addresses of a gateway
+// interface, in the order they are derived from a subnet.
+var networkGatewayInterfaceV2AddressKeys = []string{"gw_vipv4", "primary_ipv4", "secondary_ipv4"}
+
+// validateNetworkGatewayInterfaceV2VRID validates the integer vrid with
+// ValidateVRID.
+func validateNetworkGatewayInterfaceV2VRID(v interface{}, k string) ([]string, []error) {
+	return ValidateVRID()(strconv.Itoa(v.(int)), k)
+}
+
+// networkGatewayInterfaceV2ValidateAddresses checks that the addresses,
+// keyed by attribute, are distinct host addresses of a single network of the
+// netmask, and that the netmask matches the subnet CIDR. Empty addresses, a
+// zero netmask and an empty CIDR are not known yet and are not checked.
+func networkGatewayInterfaceV2ValidateAddresses(addresses map[string]string, netmask int, cidr string) error {
+	if netmask != 0 && (netmask < 1 || netmask > 29) {
+		return fmt.Errorf("netmask must be between 1 and 29 to hold the three addresses of VRRP, got %d", netmask)
+	}
+
+	var subnet *net.IPNet
+	if cidr != "" {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid subnet CIDR %q: %w", cidr, err)
+		}
+		if ones, _ := ipNet.Mask.Size(); netmask != 0 && ones != netmask {
+			return fmt.Errorf("netmask %d does not match the subnet CIDR %s", netmask, cidr)
+		}
+		subnet = ipNet
+		if netmask == 0 {
+			netmask, _ = ipNet.Mask.Size()
+		}
+	}
+
+	seen := make(map[string]string)
+	var network *net.IPNet
+	var networkKey string
+	for _, k := range networkGatewayInterfaceV2AddressKeys {
+		v := addresses[k]
+		if v == "" {
+			continue
+		}
+
+		ip := net.ParseIP(v).To4()
+		if ip == nil {
+			return fmt.Errorf("%s must be an IPv4 address, got %q", k, v)
+		}
+
+		if other, ok := seen[ip.String()]; ok {
+			return fmt.Errorf("%s and %s must be different addresses, got %s", other, k, v)
+		}
+		seen[ip.String()] = k
+
+		if subnet != nil && !subnet.Contains(ip) {
+			return fmt.Errorf("%s %s is not in the subnet CIDR %s", k, v, cidr)
+		}
+
+		if netmask == 0 {
+			continue
+		}
+
+		mask := net.CIDRMask(netmask, 32)
+		ipNet := &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+		broadcast := make(net.IP, len(ipNet.IP))
+		for i := range ipNet.IP {
+			broadcast[i] = ipNet.IP[i] | ^mask[i]
+		}
+		if ip.Equal(ipNet.IP) || ip.Equal(broadcast) {
+			return fmt.Errorf("%s %s is the network or broadcast address of %s", k, v, ipNet)
+		}
+
+		if network == nil {
+			network, networkKey = ipNet, k
+			continue
+		}
+		if !network.IP.Equal(ipNet.IP) {
+			return fmt.Errorf("%s %s is not in the same network %s as %s", k, v, network, networkKey)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package ecl
+
+import (
+	"testing"
+)
+
+func TestNetworkGatewayInterfaceV2ValidateAddresses(t *testing.T) {
+	cases := []struct {
+		gwVIP     string
+		primary   string
+		secondary string
+		netmask   int
+		cidr      string
+		valid     bool
+	}{
+		{"192.168.200.1", "192.168.200.2", "192.168.200.3", 29, "", true},
+		{"192.168.200.1", "192.168.200.2", "192.168.200.3", 29, "192.168.200.0/29", true},
+		{"192.168.200.1", "", "", 29, "192.168.200.0/29", true},
+		{"192.168.200.1", "192.168.200.2", "192.168.200.3", 0, "", true},
+		{"192.168.200.1", "192.168.200.2", "192.168.200.3", 0, "192.168.200.0/29", true},
+		{"192.168.200.1", "192.168.200.2", "192.168.200.2", 29, "", false},
+		{"192.168.200.1", "192.168.200.2", "192.168.200.9", 29, "", false},
+		{"192.168.200.1", "192.168.200.2", "192.168.200.3", 29, "192.168.200.0/28", false},
+		{"192.168.200.1", "192.168.200.2", "192.168.201.3", 0, "192.168.200.0/24", false},
+		{"192.168.200.0", "192.168.200.2", "192.168.200.3", 29, "", false},
+		{"192.168.200.1", "192.168.200.2", "192.168.200.7", 29, "", false},
+		{"192.168.200.1", "192.168.200.2", "192.168.200.3", 30, "", false},
+		{"2001:db8::1", "192.168.200.2", "192.168.200.3", 29, "", false},
+	}
+
+	for _, c := range cases {
+		addresses := map[string]string{
+			"gw_vipv4":       c.gwVIP,
+			"primary_ipv4":   c.primary,
+			"secondary_ipv4": c.secondary,
+		}
+		err := networkGatewayInterfaceV2ValidateAddresses(addresses, c.netmask, c.cidr)
+		if c.valid && err != nil {
+			t.Fatalf("%v/%d in %q: unexpected error: %s", addresses, c.netmask, c.cidr, err)
+		}
+		if !c.valid && err == nil {
+			t.Fatalf("%v/%d in %q: expected an error", addresses, c.netmask, c.cidr)
+		}
+	}
+}
+
+func TestValidateNetworkGatewayInterfaceV2VRID(t *testing.T) {
+	for _, v := range []int{0, 1, 255} {
+		if _, es := validateNetworkGatewayInterfaceV2VRID(v, "vrid"); len(es) > 0 {
+			t.Fatalf("vrid %d: unexpected errors: %v", v, es)
+		}
+	}
+	for _, v := range []int{-1, 256} {
+		if _, es := validateNetworkGatewayInterfaceV2VRID(v, "vrid"); len(es) == 0 {
+			t.Fatalf("vrid %d: expected an error", v)
+		}
+	}
+}
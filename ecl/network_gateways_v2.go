@@ -0,0 +1,157 @@
+package ecl
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/pagination"
+)
+
+// NetworkGatewayV2 represents a gateway of the network service which has no
+// package in eclcloud: an AWS, Azure, GCP, VPN or Inter DC gateway.
+type NetworkGatewayV2 struct {
+	// Description is the description of the gateway.
+	Description string `json:"description"`
+
+	// ID is the unique ID of the gateway.
+	ID string `json:"id"`
+
+	// Name is the name of the gateway.
+	Name string `json:"name"`
+
+	// QoSOptionID is the QoS option selected for the gateway.
+	// Inter DC gateways have no QoS option.
+	QoSOptionID string `json:"qos_option_id"`
+
+	// Status is the status of the gateway.
+	Status string `json:"status"`
+
+	// TenantID is the tenant ID of the owner.
+	TenantID string `json:"tenant_id"`
+
+	// The service instantiated by the gateway. Only the one of the type of
+	// the gateway is set.
+	AWSServiceID     string `json:"aws_service_id"`
+	AzureServiceID   string `json:"azure_service_id"`
+	GCPServiceID     string `json:"gcp_service_id"`
+	InterDCServiceID string `json:"interdc_service_id"`
+	VPNServiceID     string `json:"vpn_service_id"`
+}
+
+// serviceID returns the service ID of the gateway of the kind, e.g. "aws".
+func (gw *NetworkGatewayV2) serviceID(kind string) string {
+	opts := NetworkGatewayV2ListOpts{
+		AWSServiceID:     gw.AWSServiceID,
+		AzureServiceID:   gw.AzureServiceID,
+		GCPServiceID:     gw.GCPServiceID,
+		InterDCServiceID: gw.InterDCServiceID,
+		VPNServiceID:     gw.VPNServiceID,
+	}
+	return *opts.serviceID(kind)
+}
+
+// NetworkGatewayV2ListOpts filters the gateways listed by networkGatewayV2List.
+type NetworkGatewayV2ListOpts struct {
+	Description string `q:"description"`
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	QoSOptionID string `q:"qos_option_id"`
+	Status      string `q:"status"`
+	TenantID    string `q:"tenant_id"`
+
+	AWSServiceID     string `q:"aws_service_id"`
+	AzureServiceID   string `q:"azure_service_id"`
+	GCPServiceID     string `q:"gcp_service_id"`
+	InterDCServiceID string `q:"interdc_service_id"`
+	VPNServiceID     string `q:"vpn_service_id"`
+}
+
+// serviceID returns the service ID option of the gateways of the kind, e.g.
+// "aws".
+func (opts *NetworkGatewayV2ListOpts) serviceID(kind string) *string {
+	switch kind {
+	case "aws":
+		return &opts.AWSServiceID
+	case "azure":
+		return &opts.AzureServiceID
+	case "gcp":
+		return &opts.GCPServiceID
+	case "interdc":
+		return &opts.InterDCServiceID
+	case "vpn":
+		return &opts.VPNServiceID
+	}
+	panic(fmt.Sprintf("unknown network gateway kind %q", kind))
+}
+
+// NetworkGatewayV2Page is a single page of gateway results.
+type NetworkGatewayV2Page struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a NetworkGatewayV2Page is empty.
+func (page NetworkGatewayV2Page) IsEmpty() (bool, error) {
+	gws, err := ExtractNetworkGatewaysV2(page)
+	return len(gws) == 0, err
+}
+
+// ExtractNetworkGatewaysV2 interprets a page of results as a slice of NetworkGatewayV2.
+func ExtractNetworkGatewaysV2(r pagination.Page) ([]NetworkGatewayV2, error) {
+	var m map[string]json.RawMessage
+	if err := (r.(NetworkGatewayV2Page)).ExtractInto(&m); err != nil {
+		return nil, err
+	}
+
+	// The gateways are keyed by their collection, e.g. "aws_gateways".
+	var s []NetworkGatewayV2
+	var err error
+	for k, v := range m {
+		if strings.HasSuffix(k, "_gateways") {
+			err = json.Unmarshal(v, &s)
+		}
+	}
+	return s, err
+}
+
+// networkGatewayV2List returns a Pager that allows you to iterate over the
+// gateways of the collection, e.g. "aws_gateways".
+func networkGatewayV2List(client *eclcloud.ServiceClient, collection string, opts NetworkGatewayV2ListOpts) pagination.Pager {
+	url := client.ServiceURL(collection)
+	query, err := eclcloud.BuildQueryString(opts)
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	url += query.String()
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return NetworkGatewayV2Page{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// networkGatewayV2Find returns the only gateway of the collection matching
+// the options.
+func networkGatewayV2Find(client *eclcloud.ServiceClient, collection string, opts NetworkGatewayV2ListOpts) (*NetworkGatewayV2, error) {
+	pages, err := networkGatewayV2List(client, collection, opts).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve %s: %w", collection, err)
+	}
+
+	gws, err := ExtractNetworkGatewaysV2(pages)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract %s: %w", collection, err)
+	}
+
+	if len(gws) < 1 {
+		return nil, fmt.Errorf("your query returned no results." +
+			" please change your search criteria and try again")
+	}
+
+	if len(gws) > 1 {
+		return nil, fmt.Errorf("your query returned more than one result." +
+			" please try a more specific search criteria")
+	}
+
+	return &gws[0], nil
+}
@@ -0,0 +1,166 @@
+package ecl
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/security_group_rules"
+)
+
+// networkSecurityGroupRuleV2ProtocolNames maps the protocol numbers accepted
+// by the API to the names it returns.
+var networkSecurityGroupRuleV2ProtocolNames = map[string]string{
+	"1":  "icmp",
+	"6":  "tcp",
+	"17": "udp",
+	"58": "ipv6-icmp",
+}
+
+// networkSecurityGroupRuleV2NormalizeProtocol returns the protocol in the
+// form returned by the API. "any" and the empty string both mean all
+// protocols.
+func networkSecurityGroupRuleV2NormalizeProtocol(v interface{}) string {
+	protocol := strings.ToLower(strings.TrimSpace(v.(string)))
+	if name, ok := networkSecurityGroupRuleV2ProtocolNames[protocol]; ok {
+		return name
+	}
+	if protocol == "any" {
+		return ""
+	}
+	return protocol
+}
+
+// networkSecurityGroupRuleV2NormalizeRemoteIPPrefix returns the network
+// address of the prefix, so that host bits and a missing prefix length do not
+// produce a diff. A prefix matching every address is the same as no prefix.
+func networkSecurityGroupRuleV2NormalizeRemoteIPPrefix(v interface{}) string {
+	prefix := strings.TrimSpace(v.(string))
+	if prefix == "" {
+		return ""
+	}
+
+	if !strings.Contains(prefix, "/") {
+		if ip := net.ParseIP(prefix); ip != nil {
+			if ip.To4() != nil {
+				prefix += "/32"
+			} else {
+				prefix += "/128"
+			}
+		}
+	}
+
+	_, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return prefix
+	}
+
+	if ones, _ := ipNet.Mask.Size(); ones == 0 {
+		return ""
+	}
+
+	return ipNet.String()
+}
+
+// networkSecurityGroupRuleV2NormalizePortRange returns the port range with
+// the full range, which the API returns for rules without ports, as no range.
+func networkSecurityGroupRuleV2NormalizePortRange(min, max int) (int, int) {
+	if min == 0 && max == 65535 {
+		return 0, 0
+	}
+	return min, max
+}
+
+// networkSecurityGroupRuleV2PortRangeMaxDiffSuppress suppresses the diff
+// between no range and the full range of the same rule.
+func networkSecurityGroupRuleV2PortRangeMaxDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	isUnbounded := func(v string) bool {
+		return v == "0" || v == "65535"
+	}
+	return isUnbounded(old) && isUnbounded(new)
+}
+
+// networkSecurityGroupRuleV2Key returns a string identifying a rule of
+// ecl_network_security_group_rules_v2 by its normalized values.
+func networkSecurityGroupRuleV2Key(m map[string]interface{}) string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s-", m["direction"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["ethertype"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", networkSecurityGroupRuleV2NormalizeProtocol(m["protocol"])))
+	min, max := networkSecurityGroupRuleV2NormalizePortRange(m["port_range_min"].(int), m["port_range_max"].(int))
+	buf.WriteString(fmt.Sprintf("%d-", min))
+	buf.WriteString(fmt.Sprintf("%d-", max))
+	buf.WriteString(fmt.Sprintf("%s-", networkSecurityGroupRuleV2NormalizeRemoteIPPrefix(m["remote_ip_prefix"])))
+	buf.WriteString(fmt.Sprintf("%s-", m["remote_group_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["description"].(string)))
+	return buf.String()
+}
+
+func networkSecurityGroupRuleV2Hash(v interface{}) int {
+	return hashcode.String(networkSecurityGroupRuleV2Key(v.(map[string]interface{})))
+}
+
+// flattenNetworkSecurityGroupRuleV2 returns the rule in the form of the rule
+// of ecl_network_security_group_rules_v2.
+func flattenNetworkSecurityGroupRuleV2(rule security_group_rules.SecurityGroupRule) map[string]interface{} {
+	m := map[string]interface{}{
+		"direction":        rule.Direction,
+		"ethertype":        rule.Ethertype,
+		"protocol":         networkSecurityGroupRuleV2NormalizeProtocol(rule.Protocol),
+		"port_range_min":   0,
+		"port_range_max":   0,
+		"remote_ip_prefix": "",
+		"remote_group_id":  "",
+		"description":      rule.Description,
+	}
+
+	var min, max int
+	if rule.PortRangeMin != nil {
+		min = *rule.PortRangeMin
+	}
+	if rule.PortRangeMax != nil {
+		max = *rule.PortRangeMax
+	}
+	m["port_range_min"], m["port_range_max"] = networkSecurityGroupRuleV2NormalizePortRange(min, max)
+
+	if rule.RemoteIPPrefix != nil {
+		m["remote_ip_prefix"] = networkSecurityGroupRuleV2NormalizeRemoteIPPrefix(*rule.RemoteIPPrefix)
+	}
+	if rule.RemoteGroupID != nil {
+		m["remote_group_id"] = *rule.RemoteGroupID
+	}
+
+	return m
+}
+
+// expandNetworkSecurityGroupRuleV2CreateOpts builds the options to create the
+// rule of ecl_network_security_group_rules_v2 in the security group.
+func expandNetworkSecurityGroupRuleV2CreateOpts(m map[string]interface{}, sgID string) security_group_rules.CreateOpts {
+	createOpts := security_group_rules.CreateOpts{
+		Description:     m["description"].(string),
+		Direction:       m["direction"].(string),
+		Ethertype:       m["ethertype"].(string),
+		Protocol:        networkSecurityGroupRuleV2NormalizeProtocol(m["protocol"]),
+		SecurityGroupID: sgID,
+	}
+
+	min, max := networkSecurityGroupRuleV2NormalizePortRange(m["port_range_min"].(int), m["port_range_max"].(int))
+	if min > 0 {
+		createOpts.PortRangeMin = &min
+	}
+	if max > 0 {
+		createOpts.PortRangeMax = &max
+	}
+	if v := networkSecurityGroupRuleV2NormalizeRemoteIPPrefix(m["remote_ip_prefix"]); v != "" {
+		createOpts.RemoteIPPrefix = &v
+	}
+	if v := m["remote_group_id"].(string); v != "" {
+		createOpts.RemoteGroupID = &v
+	}
+
+	return createOpts
+}
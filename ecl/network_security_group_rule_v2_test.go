@@ -0,0 +1,72 @@
+package ecl
+
+import (
+	"testing"
+)
+
+func TestNetworkSecurityGroupRuleV2NormalizeProtocol(t *testing.T) {
+	cases := map[string]string{
+		"":     "",
+		"any":  "",
+		"TCP":  "tcp",
+		"6":    "tcp",
+		"17":   "udp",
+		"1":    "icmp",
+		"58":   "ipv6-icmp",
+		"vrrp": "vrrp",
+		"112":  "112",
+	}
+
+	for in, expected := range cases {
+		if v := networkSecurityGroupRuleV2NormalizeProtocol(in); v != expected {
+			t.Fatalf("expected %q to be normalized to %q, got %q", in, expected, v)
+		}
+	}
+}
+
+func TestNetworkSecurityGroupRuleV2NormalizeRemoteIPPrefix(t *testing.T) {
+	cases := map[string]string{
+		"":                "",
+		"0.0.0.0/0":       "",
+		"::/0":            "",
+		"192.168.1.0/24":  "192.168.1.0/24",
+		"192.168.1.10/24": "192.168.1.0/24",
+		"192.168.1.10":    "192.168.1.10/32",
+		"2001:DB8::/32":   "2001:db8::/32",
+		"2001:db8::1":     "2001:db8::1/128",
+		"invalid":         "invalid",
+	}
+
+	for in, expected := range cases {
+		if v := networkSecurityGroupRuleV2NormalizeRemoteIPPrefix(in); v != expected {
+			t.Fatalf("expected %q to be normalized to %q, got %q", in, expected, v)
+		}
+	}
+}
+
+func TestNetworkSecurityGroupRuleV2Key(t *testing.T) {
+	rule := func(protocol, prefix string, min, max int) map[string]interface{} {
+		return map[string]interface{}{
+			"direction":        "ingress",
+			"ethertype":        "IPv4",
+			"protocol":         protocol,
+			"port_range_min":   min,
+			"port_range_max":   max,
+			"remote_ip_prefix": prefix,
+			"remote_group_id":  "",
+			"description":      "",
+		}
+	}
+
+	if networkSecurityGroupRuleV2Key(rule("6", "10.0.0.1/8", 22, 22)) != networkSecurityGroupRuleV2Key(rule("tcp", "10.0.0.0/8", 22, 22)) {
+		t.Fatal("expected equivalent rules to have the same key")
+	}
+
+	if networkSecurityGroupRuleV2Key(rule("any", "0.0.0.0/0", 0, 65535)) != networkSecurityGroupRuleV2Key(rule("", "", 0, 0)) {
+		t.Fatal("expected rules without ports to have the same key as rules with the full range")
+	}
+
+	if networkSecurityGroupRuleV2Key(rule("tcp", "10.0.0.0/8", 22, 22)) == networkSecurityGroupRuleV2Key(rule("udp", "10.0.0.0/8", 22, 22)) {
+		t.Fatal("expected different rules to have different keys")
+	}
+}
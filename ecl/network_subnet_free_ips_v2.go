@@ -0,0 +1,197 @@
+package ecl
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/ports"
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/subnets"
+)
+
+// networkSubnetV2AllocateIPs returns count unused addresses of the subnet.
+// The gateway IP, the fixed IPs of the ports in the subnet and the excluded
+// addresses are not used.
+func networkSubnetV2AllocateIPs(networkClient *eclcloud.ServiceClient, subnet *subnets.Subnet, excluded []string, count int) ([]string, error) {
+	ranges, err := networkSubnetV2IPRanges(subnet.CIDR, subnet.AllocationPools)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get IP address ranges of subnet %s: %s", subnet.ID, err)
+	}
+
+	allPages, err := ports.List(networkClient, ports.ListOpts{NetworkID: subnet.NetworkID}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list ports of network %s: %s", subnet.NetworkID, err)
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve ports of network %s: %s", subnet.NetworkID, err)
+	}
+
+	used := append([]string{}, excluded...)
+	if subnet.GatewayIP != "" {
+		used = append(used, subnet.GatewayIP)
+	}
+	for _, p := range allPorts {
+		for _, ip := range p.FixedIPs {
+			if ip.SubnetID == subnet.ID {
+				used = append(used, ip.IPAddress)
+			}
+		}
+	}
+
+	ipAddresses, err := networkSubnetV2FreeIPs(ranges, used, count)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to allocate IP addresses from subnet %s: %s", subnet.ID, err)
+	}
+
+	return ipAddresses, nil
+}
+
+// networkSubnetV2IPRange is an inclusive range of IP addresses.
+type networkSubnetV2IPRange struct {
+	start net.IP
+	end   net.IP
+}
+
+// networkSubnetV2IPRanges returns the ranges addresses are allocated from:
+// the allocation pools, or every host address of the CIDR without pools.
+func networkSubnetV2IPRanges(cidr string, pools []subnets.AllocationPool) ([]networkSubnetV2IPRange, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid CIDR %q: %s", cidr, err)
+	}
+
+	if len(pools) == 0 {
+		start := networkSubnetV2NormalizeIP(ipNet.IP)
+		end := make(net.IP, len(start))
+		for i := range start {
+			end[i] = start[i] | ^ipNet.Mask[i]
+		}
+
+		// The network and broadcast addresses are not assignable in IPv4.
+		if len(start) == net.IPv4len {
+			start = networkSubnetV2NextIP(start)
+			end = networkSubnetV2PrevIP(end)
+		}
+		return []networkSubnetV2IPRange{{start: start, end: end}}, nil
+	}
+
+	ranges := make([]networkSubnetV2IPRange, 0, len(pools))
+	for _, pool := range pools {
+		start := net.ParseIP(pool.Start)
+		end := net.ParseIP(pool.End)
+		if start == nil || end == nil {
+			return nil, fmt.Errorf("Invalid allocation pool %s-%s", pool.Start, pool.End)
+		}
+		ranges = append(ranges, networkSubnetV2IPRange{
+			start: networkSubnetV2NormalizeIP(start),
+			end:   networkSubnetV2NormalizeIP(end),
+		})
+	}
+
+	// Sort the pools so that the result does not depend on their order.
+	sort.Slice(ranges, func(i, j int) bool {
+		return bytes.Compare(ranges[i].start, ranges[j].start) < 0
+	})
+
+	return ranges, nil
+}
+
+// networkSubnetV2FreeIPs returns the first count addresses of the ranges,
+// in ascending order, which are not excluded.
+func networkSubnetV2FreeIPs(ranges []networkSubnetV2IPRange, excluded []string, count int) ([]string, error) {
+	excludedIPs := make(map[string]bool)
+	var excludedNets []*net.IPNet
+	for _, v := range excluded {
+		if strings.Contains(v, "/") {
+			_, ipNet, err := net.ParseCIDR(v)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid excluded CIDR %q: %s", v, err)
+			}
+			excludedNets = append(excludedNets, ipNet)
+			continue
+		}
+
+		ip := net.ParseIP(v)
+		if ip == nil {
+			return nil, fmt.Errorf("Invalid excluded IP address %q", v)
+		}
+		excludedIPs[ip.String()] = true
+	}
+
+	isExcluded := func(ip net.IP) bool {
+		if excludedIPs[ip.String()] {
+			return true
+		}
+		for _, ipNet := range excludedNets {
+			if ipNet.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var result []string
+	seen := make(map[string]bool)
+	for _, r := range ranges {
+		for ip := r.start; bytes.Compare(ip, r.end) <= 0; ip = networkSubnetV2NextIP(ip) {
+			if len(result) == count {
+				return result, nil
+			}
+
+			s := ip.String()
+			if !seen[s] && !isExcluded(ip) {
+				result = append(result, s)
+			}
+			seen[s] = true
+
+			// Stop at the last address instead of wrapping around.
+			if ip.Equal(r.end) {
+				break
+			}
+		}
+	}
+
+	if len(result) < count {
+		return nil, fmt.Errorf("Only %d free IP addresses are available, %d requested", len(result), count)
+	}
+
+	return result, nil
+}
+
+// networkSubnetV2NormalizeIP returns IPv4 addresses in their 4-byte form so
+// that they can be compared with bytes.Compare.
+func networkSubnetV2NormalizeIP(ip net.IP) net.IP {
+	if v4 := ip.To4(); v4 != nil {
+		return v4
+	}
+	return ip.To16()
+}
+
+func networkSubnetV2NextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
+func networkSubnetV2PrevIP(ip net.IP) net.IP {
+	prev := make(net.IP, len(ip))
+	copy(prev, ip)
+	for i := len(prev) - 1; i >= 0; i-- {
+		prev[i]--
+		if prev[i] != 0xff {
+			break
+		}
+	}
+	return prev
+}
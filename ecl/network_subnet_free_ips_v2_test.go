@@ -0,0 +1,89 @@
+package ecl
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/subnets"
+)
+
+func TestNetworkSubnetV2FreeIPs(t *testing.T) {
+	cases := []struct {
+		cidr     string
+		pools    []subnets.AllocationPool
+		excluded []string
+		count    int
+		expected []string
+		valid    bool
+	}{
+		{
+			cidr:     "192.168.1.0/24",
+			pools:    []subnets.AllocationPool{{Start: "192.168.1.1", End: "192.168.1.10"}},
+			excluded: []string{"192.168.1.1", "192.168.1.2", "192.168.1.4"},
+			count:    3, valid: true,
+			expected: []string{"192.168.1.3", "192.168.1.5", "192.168.1.6"},
+		},
+		{
+			cidr: "192.168.1.0/24",
+			pools: []subnets.AllocationPool{
+				{Start: "192.168.1.200", End: "192.168.1.201"},
+				{Start: "192.168.1.100", End: "192.168.1.101"},
+			},
+			count: 3, valid: true,
+			expected: []string{"192.168.1.100", "192.168.1.101", "192.168.1.200"},
+		},
+		{
+			cidr:     "192.168.1.0/24",
+			pools:    []subnets.AllocationPool{{Start: "192.168.1.1", End: "192.168.1.10"}},
+			excluded: []string{"192.168.1.0/29"},
+			count:    2, valid: true,
+			expected: []string{"192.168.1.8", "192.168.1.9"},
+		},
+		{
+			cidr:  "192.168.1.0/30",
+			count: 2, valid: true,
+			expected: []string{"192.168.1.1", "192.168.1.2"},
+		},
+		{
+			cidr:  "192.168.1.252/30",
+			count: 3, valid: false,
+		},
+		{
+			cidr:     "2001:db8::/64",
+			pools:    []subnets.AllocationPool{{Start: "2001:db8::ff", End: "2001:db8::1:0"}},
+			excluded: []string{"2001:db8::100"},
+			count:    2, valid: true,
+			expected: []string{"2001:db8::ff", "2001:db8::101"},
+		},
+		{
+			cidr:     "192.168.1.0/24",
+			pools:    []subnets.AllocationPool{{Start: "192.168.1.254", End: "192.168.1.254"}},
+			excluded: []string{"192.168.1.254"},
+			count:    1, valid: false,
+		},
+		{
+			cidr:     "192.168.1.0/24",
+			excluded: []string{"192.168.1"},
+			count:    1, valid: false,
+		},
+		{cidr: "192.168.1.0", count: 1, valid: false},
+	}
+
+	for _, c := range cases {
+		ranges, err := networkSubnetV2IPRanges(c.cidr, c.pools)
+		if err == nil {
+			var actual []string
+			actual, err = networkSubnetV2FreeIPs(ranges, c.excluded, c.count)
+			if err == nil && !reflect.DeepEqual(actual, c.expected) {
+				t.Fatalf("free IPs of %s %v: expected %v, got %v", c.cidr, c.pools, c.expected, actual)
+			}
+		}
+
+		if c.valid && err != nil {
+			t.Fatalf("free IPs of %s %v: unexpected error: %s", c.cidr, c.pools, err)
+		}
+		if !c.valid && err == nil {
+			t.Fatalf("free IPs of %s %v: expected an error", c.cidr, c.pools)
+		}
+	}
+}
@@ -175,49 +175,80 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("OS_FORCE_SSS_ENDPOINT", ""),
 				Description: descriptions["force_sss_endpoint"],
 			},
+
+			"mlb_auto_apply_configurations": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_MLB_AUTO_APPLY_CONFIGURATIONS", false),
+				Description: descriptions["mlb_auto_apply_configurations"],
+			},
+
+			"mlb_cancel_configurations_on_failure": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_MLB_CANCEL_CONFIGURATIONS_ON_FAILURE", false),
+				Description: descriptions["mlb_cancel_configurations_on_failure"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"ecl_baremetal_availability_zone_v2":     dataSourceBaremetalAvailabilityZoneV2(),
-			"ecl_baremetal_flavor_v2":                dataSourceBaremetalFlavorV2(),
-			"ecl_baremetal_keypair_v2":               dataSourceBaremetalKeypairV2(),
-			"ecl_compute_flavor_v2":                  dataSourceComputeFlavorV2(),
-			"ecl_compute_keypair_v2":                 dataSourceComputeKeypairV2(),
-			"ecl_dns_zone_v2":                        dataSourceDNSZoneV2(),
-			"ecl_imagestorages_image_v2":             dataSourceImagesImageV2(),
-			"ecl_mlb_certificate_v1":                 dataSourceMLBCertificateV1(),
-			"ecl_mlb_health_monitor_v1":              dataSourceMLBHealthMonitorV1(),
-			"ecl_mlb_listener_v1":                    dataSourceMLBListenerV1(),
-			"ecl_mlb_load_balancer_v1":               dataSourceMLBLoadBalancerV1(),
-			"ecl_mlb_operation_v1":                   dataSourceMLBOperationV1(),
-			"ecl_mlb_plan_v1":                        dataSourceMLBPlanV1(),
-			"ecl_mlb_policy_v1":                      dataSourceMLBPolicyV1(),
-			"ecl_mlb_route_v1":                       dataSourceMLBRouteV1(),
-			"ecl_mlb_rule_v1":                        dataSourceMLBRuleV1(),
-			"ecl_mlb_system_update_v1":               dataSourceMLBSystemUpdateV1(),
-			"ecl_mlb_target_group_v1":                dataSourceMLBTargetGroupV1(),
-			"ecl_mlb_tls_policy_v1":                  dataSourceMLBTLSPolicyV1(),
-			"ecl_network_common_function_gateway_v2": dataSourceNetworkCommonFunctionGatewayV2(),
-			"ecl_network_common_function_pool_v2":    dataSourceNetworkCommonFunctionPoolV2(),
-			"ecl_network_fic_gateway_v2":             dataSourceNetworkFICGatewayV2(),
-			"ecl_network_gateway_interface_v2":       dataSourceNetworkGatewayInterfaceV2(),
-			"ecl_network_internet_gateway_v2":        dataSourceNetworkInternetGatewayV2(),
-			"ecl_network_internet_service_v2":        dataSourceNetworkInternetServiceV2(),
-			"ecl_network_load_balancer_plan_v2":      dataSourceNetworkLoadBalancerPlanV2(),
-			"ecl_network_network_v2":                 dataSourceNetworkNetworkV2(),
-			"ecl_network_qos_options_v2":             dataSourceNetworkQosOptionsV2(),
-			"ecl_network_port_v2":                    dataSourceNetworkPortV2(),
-			"ecl_network_public_ip_v2":               dataSourceNetworkPublicIPV2(),
-			"ecl_network_security_group_v2":          dataSourceNetworkSecurityGroupV2(),
-			"ecl_network_security_group_rule_v2":     dataSourceNetworkSecurityGroupRuleV2(),
-			"ecl_network_static_route_v2":            dataSourceNetworkStaticRouteV2(),
-			"ecl_network_subnet_v2":                  dataSourceNetworkSubnetV2(),
-			"ecl_sss_tenant_v1":                      dataSourceSSSTenantV1(),
-			"ecl_storage_virtualstorage_v1":          dataSourceStorageVirtualStorageV1(),
-			"ecl_storage_volume_v1":                  dataSourceStorageVolumeV1(),
-			"ecl_storage_volumetype_v1":              dataSourceStorageVolumeTypeV1(),
-			"ecl_vna_appliance_v1":                   dataSourceVNAApplianceV1(),
-			"ecl_vna_appliance_plan_v1":              dataSourceVNAAppliancePlanV1(),
+			"ecl_baremetal_availability_zone_v2":         dataSourceBaremetalAvailabilityZoneV2(),
+			"ecl_baremetal_flavor_v2":                    dataSourceBaremetalFlavorV2(),
+			"ecl_baremetal_keypair_v2":                   dataSourceBaremetalKeypairV2(),
+			"ecl_baremetal_server_v2":                    dataSourceBaremetalServerV2(),
+			"ecl_baremetal_servers_v2":                   dataSourceBaremetalServersV2(),
+			"ecl_compute_flavor_v2":                      dataSourceComputeFlavorV2(),
+			"ecl_compute_keypair_v2":                     dataSourceComputeKeypairV2(),
+			"ecl_dedicated_hypervisor_licenses_v1":       dataSourceDedicatedHypervisorLicensesV1(),
+			"ecl_dedicated_hypervisor_servers_v1":        dataSourceDedicatedHypervisorServersV1(),
+			"ecl_dns_zone_file_v2":                       dataSourceDNSZoneFileV2(),
+			"ecl_dns_zone_v2":                            dataSourceDNSZoneV2(),
+			"ecl_imagestorages_image_v2":                 dataSourceImagesImageV2(),
+			"ecl_imagestorages_images_v2":                dataSourceImagesImagesV2(),
+			"ecl_mlb_certificate_v1":                     dataSourceMLBCertificateV1(),
+			"ecl_mlb_health_monitor_v1":                  dataSourceMLBHealthMonitorV1(),
+			"ecl_mlb_listener_v1":                        dataSourceMLBListenerV1(),
+			"ecl_mlb_load_balancer_v1":                   dataSourceMLBLoadBalancerV1(),
+			"ecl_mlb_operation_v1":                       dataSourceMLBOperationV1(),
+			"ecl_mlb_plan_v1":                            dataSourceMLBPlanV1(),
+			"ecl_mlb_policy_v1":                          dataSourceMLBPolicyV1(),
+			"ecl_mlb_route_v1":                           dataSourceMLBRouteV1(),
+			"ecl_mlb_rule_v1":                            dataSourceMLBRuleV1(),
+			"ecl_mlb_system_update_v1":                   dataSourceMLBSystemUpdateV1(),
+			"ecl_mlb_target_group_v1":                    dataSourceMLBTargetGroupV1(),
+			"ecl_mlb_tls_policy_v1":                      dataSourceMLBTLSPolicyV1(),
+			"ecl_network_aws_gateway_v2":                 dataSourceNetworkGatewayV2("aws", "aws_gateways"),
+			"ecl_network_azure_gateway_v2":               dataSourceNetworkGatewayV2("azure", "azure_gateways"),
+			"ecl_network_common_function_gateway_v2":     dataSourceNetworkCommonFunctionGatewayV2(),
+			"ecl_network_common_function_pool_v2":        dataSourceNetworkCommonFunctionPoolV2(),
+			"ecl_network_fic_gateway_v2":                 dataSourceNetworkFICGatewayV2(),
+			"ecl_network_gateway_interface_v2":           dataSourceNetworkGatewayInterfaceV2(),
+			"ecl_network_gcp_gateway_v2":                 dataSourceNetworkGatewayV2("gcp", "gcp_gateways"),
+			"ecl_network_interdc_gateway_v2":             dataSourceNetworkGatewayV2("interdc", "interdc_gateways"),
+			"ecl_network_internet_gateway_v2":            dataSourceNetworkInternetGatewayV2(),
+			"ecl_network_internet_service_v2":            dataSourceNetworkInternetServiceV2(),
+			"ecl_network_load_balancer_interface_v2":     dataSourceNetworkLoadBalancerInterfaceV2(),
+			"ecl_network_load_balancer_plan_v2":          dataSourceNetworkLoadBalancerPlanV2(),
+			"ecl_network_load_balancer_syslog_server_v2": dataSourceNetworkLoadBalancerSyslogServerV2(),
+			"ecl_network_network_v2":                     dataSourceNetworkNetworkV2(),
+			"ecl_network_networks_v2":                    dataSourceNetworkNetworksV2(),
+			"ecl_network_qos_options_v2":                 dataSourceNetworkQosOptionsV2(),
+			"ecl_network_port_v2":                        dataSourceNetworkPortV2(),
+			"ecl_network_ports_v2":                       dataSourceNetworkPortsV2(),
+			"ecl_network_public_ip_v2":                   dataSourceNetworkPublicIPV2(),
+			"ecl_network_security_group_v2":              dataSourceNetworkSecurityGroupV2(),
+			"ecl_network_security_group_rule_v2":         dataSourceNetworkSecurityGroupRuleV2(),
+			"ecl_network_static_route_v2":                dataSourceNetworkStaticRouteV2(),
+			"ecl_network_subnet_v2":                      dataSourceNetworkSubnetV2(),
+			"ecl_network_subnets_v2":                     dataSourceNetworkSubnetsV2(),
+			"ecl_network_subnet_free_ips_v2":             dataSourceNetworkSubnetFreeIPsV2(),
+			"ecl_network_vpn_gateway_v2":                 dataSourceNetworkGatewayV2("vpn", "vpn_gateways"),
+			"ecl_sss_tenant_v1":                          dataSourceSSSTenantV1(),
+			"ecl_storage_virtualstorage_v1":              dataSourceStorageVirtualStorageV1(),
+			"ecl_storage_volume_v1":                      dataSourceStorageVolumeV1(),
+			"ecl_storage_volumetype_v1":                  dataSourceStorageVolumeTypeV1(),
+			"ecl_vna_appliance_v1":                       dataSourceVNAApplianceV1(),
+			"ecl_vna_appliance_plan_v1":                  dataSourceVNAAppliancePlanV1(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -225,12 +256,18 @@ func Provider() terraform.ResourceProvider {
 			"ecl_baremetal_keypair_v2":                               resourceBaremetalKeypairV2(),
 			"ecl_compute_instance_v2":                                resourceComputeInstanceV2(),
 			"ecl_compute_keypair_v2":                                 resourceComputeKeypairV2(),
+			"ecl_compute_servergroup_v2":                             resourceComputeServerGroupV2(),
 			"ecl_compute_volume_attach_v2":                           resourceComputeVolumeAttachV2(),
 			"ecl_compute_volume_v2":                                  resourceComputeVolumeV2(),
 			"ecl_dedicated_hypervisor_server_v1":                     resourceDedicatedHypervisorServerV1(),
 			"ecl_dedicated_hypervisor_license_v1":                    resourceDedicatedHypervisorLicenseV1(),
+			"ecl_dns_ptr_record_v2":                                  resourceDNSPTRRecordV2(),
 			"ecl_dns_recordset_v2":                                   resourceDNSRecordSetV2(),
+			"ecl_dns_reverse_zone_v2":                                resourceDNSReverseZoneV2(),
+			"ecl_dns_zone_records_v2":                                resourceDNSZoneRecordsV2(),
 			"ecl_dns_zone_v2":                                        resourceDNSZoneV2(),
+			"ecl_imagestorages_image_download_v2":                    resourceImageStoragesImageDownloadV2(),
+			"ecl_imagestorages_image_share_v2":                       resourceImageStoragesImageShareV2(),
 			"ecl_imagestorages_image_v2":                             resourceImageStoragesImageV2(),
 			"ecl_imagestorages_member_accepter_v2":                   resourceImageStoragesMemberAccepterV2(),
 			"ecl_imagestorages_member_v2":                            resourceImageStoragesMemberV2(),
@@ -246,14 +283,19 @@ func Provider() terraform.ResourceProvider {
 			"ecl_network_common_function_gateway_v2":                 resourceNetworkCommonFunctionGatewayV2(),
 			"ecl_network_gateway_interface_v2":                       resourceNetworkGatewayInterfaceV2(),
 			"ecl_network_internet_gateway_v2":                        resourceNetworkInternetGatewayV2(),
+			"ecl_network_load_balancer_interface_v2":                 resourceNetworkLoadBalancerInterfaceV2(),
+			"ecl_network_load_balancer_syslog_server_v2":             resourceNetworkLoadBalancerSyslogServerV2(),
 			"ecl_network_load_balancer_v2":                           resourceNetworkLoadBalancerV2(),
 			"ecl_network_network_v2":                                 resourceNetworkNetworkV2(),
+			"ecl_network_port_allowed_address_pair_v2":               resourceNetworkPortAllowedAddressPairV2(),
 			"ecl_network_port_v2":                                    resourceNetworkPortV2(),
 			"ecl_network_public_ip_v2":                               resourceNetworkPublicIPV2(),
 			"ecl_network_security_group_v2":                          resourceNetworkSecurityGroupV2(),
 			"ecl_network_security_group_rule_v2":                     resourceNetworkSecurityGroupRuleV2(),
+			"ecl_network_security_group_rules_v2":                    resourceNetworkSecurityGroupRulesV2(),
 			"ecl_network_static_route_v2":                            resourceNetworkStaticRouteV2(),
 			"ecl_network_subnet_v2":                                  resourceNetworkSubnetV2(),
+			"ecl_network_subnet_free_ips_v2":                         resourceNetworkSubnetFreeIPsV2(),
 			"ecl_provider_connectivity_tenant_connection_request_v2": resourceProviderConnectivityTenantConnectionRequestV2(),
 			"ecl_provider_connectivity_tenant_connection_v2":         resourceProviderConnectivityTenantConnectionV2(),
 			"ecl_rca_user_v1":                                        resourceRCAUserV1(),
@@ -322,6 +364,12 @@ func init() {
 		"cloud": "An entry in a `clouds.yaml` file to use.",
 
 		"force_sss_endpoint": "The SSS Endpoint URL to send API.",
+
+		"mlb_auto_apply_configurations": "Apply staged configurations of managed load balancers\n" +
+			"after changing them, instead of using ecl_mlb_load_balancer_action_v1.",
+
+		"mlb_cancel_configurations_on_failure": "Cancel staged configurations of a managed load balancer\n" +
+			"when a change or an apply of them fails, with mlb_auto_apply_configurations.",
 	}
 }
 
@@ -348,6 +396,11 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 		UserDomainName:    d.Get("user_domain_name").(string),
 		Username:          d.Get("user_name").(string),
 		UserID:            d.Get("user_id").(string),
+
+		MLBAutoApplyConfigurations:       d.Get("mlb_auto_apply_configurations").(bool),
+		MLBCancelConfigurationsOnFailure: d.Get("mlb_cancel_configurations_on_failure").(bool),
+
+		mlbConfigurationsApplier: newMLBConfigurationsApplierV1(mlbAutoApplyConfigurationsDelay),
 	}
 
 	v, ok := d.GetOkExists("insecure")
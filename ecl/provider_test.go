@@ -17,6 +17,8 @@ import (
 
 var (
 	OS_ACCEPTER_TENANT_ID                    = os.Getenv("OS_ACCEPTER_TENANT_ID")
+	OS_AWS_GW_ID                             = os.Getenv("OS_AWS_GW_ID")
+	OS_AZURE_GW_ID                           = os.Getenv("OS_AZURE_GW_ID")
 	OS_BAREMETAL_ZONE                        = os.Getenv("OS_BAREMETAL_ZONE")
 	OS_COMMON_FUNCTION_POOL_DESCRIPTION      = os.Getenv("OS_COMMON_FUNCTION_POOL_DESCRIPTION")
 	OS_COMMON_FUNCTION_POOL_ID               = os.Getenv("OS_COMMON_FUNCTION_POOL_ID")
@@ -29,12 +31,15 @@ var (
 	OS_FIC_GW_NAME                           = os.Getenv("OS_FIC_GW_NAME")
 	OS_FIC_GW_QOS_OPTION_ID                  = os.Getenv("OS_FIC_GW_QOS_OPTION_ID")
 	OS_FIC_SERVICE_ID                        = os.Getenv("OS_FIC_SERVICE_ID")
+	OS_GCP_GW_ID                             = os.Getenv("OS_GCP_GW_ID")
+	OS_INTERDC_GW_ID                         = os.Getenv("OS_INTERDC_GW_ID")
 	OS_INTERNET_SERVICE_ZONE_NAME            = os.Getenv("OS_INTERNET_SERVICE_ZONE_NAME")
 	OS_QOS_OPTION_ID_100M                    = os.Getenv("OS_QOS_OPTION_ID_100M")
 	OS_QOS_OPTION_ID_10M                     = os.Getenv("OS_QOS_OPTION_ID_10M")
 	OS_REGION_NAME                           = os.Getenv("OS_REGION_NAME")
 	OS_TENANT_ID                             = os.Getenv("OS_TENANT_ID")
 	OS_VIRTUAL_NETWORK_APPLIANCE_PLAN_ID     = os.Getenv("OS_VIRTUAL_NETWORK_APPLIANCE_PLAN_ID")
+	OS_VPN_GW_ID                             = os.Getenv("OS_VPN_GW_ID")
 	OS_VOLUME_TYPE_FILE_PREMIUM_ENVIRONMENT  = os.Getenv("OS_VOLUME_TYPE_FILE_PREMIUM_ENVIRONMENT")
 	OS_VOLUME_TYPE_FILE_STANDARD_ENVIRONMENT = os.Getenv("OS_VOLUME_TYPE_FILE_STANDARD_ENVIRONMENT")
 )
@@ -313,6 +318,46 @@ func testAccPreCheckGatewayInterfaceFIC(t *testing.T) {
 	}
 }
 
+func testAccPreCheckAWSGateway(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_AWS_GW_ID == "" {
+		t.Fatal("OS_AWS_GW_ID must be set for acceptance tests of aws gateway")
+	}
+}
+
+func testAccPreCheckAzureGateway(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_AZURE_GW_ID == "" {
+		t.Fatal("OS_AZURE_GW_ID must be set for acceptance tests of azure gateway")
+	}
+}
+
+func testAccPreCheckGCPGateway(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_GCP_GW_ID == "" {
+		t.Fatal("OS_GCP_GW_ID must be set for acceptance tests of gcp gateway")
+	}
+}
+
+func testAccPreCheckInterDCGateway(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_INTERDC_GW_ID == "" {
+		t.Fatal("OS_INTERDC_GW_ID must be set for acceptance tests of interdc gateway")
+	}
+}
+
+func testAccPreCheckVPNGateway(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_VPN_GW_ID == "" {
+		t.Fatal("OS_VPN_GW_ID must be set for acceptance tests of vpn gateway")
+	}
+}
+
 func testAccPreCheckInternetGateway(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
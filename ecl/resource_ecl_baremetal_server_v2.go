@@ -5,10 +5,12 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/nttcom/eclcloud/v3"
 	"github.com/nttcom/eclcloud/v3/ecl/baremetal/v2/flavors"
@@ -20,14 +22,18 @@ func resourceBaremetalServerV2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBaremetalServerV2Create,
 		Read:   resourceBaremetalServerV2Read,
+		Update: resourceBaremetalServerV2Update,
 		Delete: resourceBaremetalServerV2Delete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceBaremetalServerV2CustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -104,7 +110,6 @@ func resourceBaremetalServerV2() *schema.Resource {
 			"metadata": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
-				ForceNew: true,
 			},
 			"key_pair": &schema.Schema{
 				Type:     schema.TypeString,
@@ -217,65 +222,26 @@ func resourceBaremetalServerV2() *schema.Resource {
 					},
 				},
 			},
-			"nic_physical_ports": &schema.Schema{
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": &schema.Schema{
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"mac_addr": &schema.Schema{
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"network_physical_port_id": &schema.Schema{
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"plane": &schema.Schema{
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"hardware_id": &schema.Schema{
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"attached_ports": &schema.Schema{
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"port_id": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"network_id": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"fixed_ips": &schema.Schema{
-										Type:     schema.TypeList,
-										Computed: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"subnet_id": &schema.Schema{
-													Type:     schema.TypeString,
-													Computed: true,
-												},
-												"ip_address": &schema.Schema{
-													Type:     schema.TypeString,
-													Computed: true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
+			"nic_physical_ports": baremetalServerV2NICPhysicalPortsSchema(),
+			"power_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "on",
+				ValidateFunc: validation.StringInSlice([]string{
+					"on", "off",
+				}, false),
+				DiffSuppressFunc: suppressBaremetalPowerStateDiffs,
+			},
+			"reboot_trigger": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reboot_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"SOFT", "HARD",
+				}, false),
 			},
 			"personality": &schema.Schema{
 				Type:     schema.TypeList,
@@ -298,6 +264,65 @@ func resourceBaremetalServerV2() *schema.Resource {
 	}
 }
 
+func resourceBaremetalServerV2CustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	layoutKeys := []string{"raid_arrays", "lvm_volume_groups", "filesystems", "personality"}
+
+	if d.Id() != "" {
+		changed := false
+		for _, k := range layoutKeys {
+			changed = changed || d.HasChange(k)
+		}
+		if !changed {
+			return nil
+		}
+	}
+
+	for _, k := range layoutKeys {
+		if !d.NewValueKnown(k) {
+			log.Printf("[DEBUG] Skip validating disk layout of baremetal server because %s is not known yet", k)
+			return nil
+		}
+	}
+
+	raidArrays := d.Get("raid_arrays").([]interface{})
+
+	// The flavor is only needed to check the size of the primary storage.
+	var flavorDisk int
+	if len(raidArrays) > 0 && d.NewValueKnown("flavor_id") && d.NewValueKnown("flavor_name") {
+		config := meta.(*Config)
+		baremetalClient, err := config.baremetalV2Client(config.Region)
+		if err != nil {
+			return fmt.Errorf("Error creating ECL baremetal client: %s", err)
+		}
+
+		flavorID := d.Get("flavor_id").(string)
+		if flavorID == "" {
+			flavorName := d.Get("flavor_name").(string)
+			if flavorName == "" {
+				return fmt.Errorf("Either flavor_id or flavor_name must be specified")
+			}
+			flavorID, err = flavors.IDFromName(baremetalClient, flavorName)
+			if err != nil {
+				return fmt.Errorf("Error retrieving ECL baremetal flavor %s: %s", flavorName, err)
+			}
+		}
+
+		flavor, err := flavors.Get(baremetalClient, flavorID).Extract()
+		if err != nil {
+			return fmt.Errorf("Error retrieving ECL baremetal flavor %s: %s", flavorID, err)
+		}
+		flavorDisk = flavor.Disk
+	}
+
+	return validateBaremetalServerV2Layout(
+		raidArrays,
+		d.Get("lvm_volume_groups").([]interface{}),
+		d.Get("filesystems").([]interface{}),
+		d.Get("personality").([]interface{}),
+		flavorDisk,
+	)
+}
+
 func resourceBaremetalServerV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	baremetalClient, err := config.baremetalV2Client(GetRegion(d, config))
@@ -397,9 +422,81 @@ func resourceBaremetalServerV2Create(d *schema.ResourceData, meta interface{}) e
 		"[DEBUG] Waiting for baremetal server (%s) to become running",
 		server.ID)
 
+	if strings.ToLower(d.Get("power_state").(string)) == "off" {
+		err = updateBaremetalServerV2Power(baremetalClient, d.Id(), baremetalServerV2PowerOff, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceBaremetalServerV2Read(d, meta)
 }
 
+// baremetalServerV2NICPhysicalPortsSchema returns the computed schema of the
+// NIC physical ports of a baremetal server.
+func baremetalServerV2NICPhysicalPortsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"mac_addr": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"network_physical_port_id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"plane": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"hardware_id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"attached_ports": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"port_id": &schema.Schema{
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"network_id": &schema.Schema{
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"fixed_ips": &schema.Schema{
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"subnet_id": &schema.Schema{
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										"ip_address": &schema.Schema{
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func getFixedIPsForState(r *servers.AttachedPort) []map[string]interface{} {
 	var result []map[string]interface{}
 	for _, f := range r.FixedIPs {
@@ -467,10 +564,64 @@ func resourceBaremetalServerV2Read(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[DEBUG] Retrieved Server %s: %+v", d.Id(), server)
 
 	d.Set("nic_physical_ports", getNICPhysicalPortsForState(server))
+	if powerState := baremetalServerV2PowerState(server.PowerState); powerState != "" {
+		d.Set("power_state", powerState)
+	}
 
 	return nil
 }
 
+func resourceBaremetalServerV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	baremetalClient, err := config.baremetalV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL baremetal client: %s", err)
+	}
+
+	if d.HasChange("metadata") {
+		metadataOpts := make(BaremetalServerMetadataOpts)
+		for k, v := range d.Get("metadata").(map[string]interface{}) {
+			metadataOpts[k] = v.(string)
+		}
+
+		log.Printf("[DEBUG] Updating metadata of ECL baremetal server (%s): %#v", d.Id(), metadataOpts)
+		err := baremetalServerV2ResetMetadata(baremetalClient, d.Id(), metadataOpts).Err
+		if err != nil {
+			return fmt.Errorf("Error updating ECL baremetal server (%s) metadata: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("power_state") {
+		power := baremetalServerV2PowerOn
+		if strings.ToLower(d.Get("power_state").(string)) == "off" {
+			power = baremetalServerV2PowerOff
+		}
+
+		err = updateBaremetalServerV2Power(baremetalClient, d.Id(), power, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("reboot_trigger") && !d.HasChange("power_state") {
+		if strings.ToLower(d.Get("power_state").(string)) == "off" {
+			log.Printf("[DEBUG] Skip rebooting ECL baremetal server (%s) because it is powered off", d.Id())
+		} else {
+			power := baremetalServerV2PowerSoftReboot
+			if d.Get("reboot_type").(string) == "HARD" {
+				power = baremetalServerV2PowerHardReboot
+			}
+
+			err = updateBaremetalServerV2Power(baremetalClient, d.Id(), power, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceBaremetalServerV2Read(d, meta)
+}
+
 func resourceBaremetalServerV2Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	baremetalClient, err := config.baremetalV2Client(GetRegion(d, config))
@@ -678,6 +829,16 @@ func getBaremetalPersonalities(client *eclcloud.ServiceClient, d *schema.Resourc
 	return BaremetalPersonalities, nil
 }
 
+// suppressBaremetalPowerStateDiffs will allow a power state other than
+// "on" or "off", e.g. while the server is in maintenance, without a diff.
+func suppressBaremetalPowerStateDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if old != "" && old != "on" && old != "off" {
+		return true
+	}
+
+	return false
+}
+
 func waitForBaremetalServerActive(baremetalClient *eclcloud.ServiceClient, serverID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		server, err := servers.Get(baremetalClient, serverID).Extract()
@@ -689,6 +850,86 @@ func waitForBaremetalServerActive(baremetalClient *eclcloud.ServiceClient, serve
 	}
 }
 
+func updateBaremetalServerV2Power(baremetalClient *eclcloud.ServiceClient, serverID, power string, timeout time.Duration) error {
+	reboot := power == baremetalServerV2PowerSoftReboot || power == baremetalServerV2PowerHardReboot
+
+	// A reboot may finish between two polls, so it is also detected
+	// by the updated timestamp of the server.
+	var updated time.Time
+	pollInterval := 30 * time.Second
+	if reboot {
+		server, err := servers.Get(baremetalClient, serverID).Extract()
+		if err != nil {
+			return fmt.Errorf("Error retrieving ECL baremetal server (%s): %s", serverID, err)
+		}
+		updated = server.Updated
+		pollInterval = 10 * time.Second
+	}
+
+	log.Printf("[DEBUG] Requesting power operation %s for ECL baremetal server (%s)", power, serverID)
+	err := baremetalServerV2UpdatePower(baremetalClient, serverID, BaremetalServerPowerOpts{Power: power}).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error requesting power operation %s for ECL baremetal server (%s): %s", power, serverID, err)
+	}
+
+	target := "on"
+	if power == baremetalServerV2PowerOff {
+		target = "off"
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"on", "off", "pending"},
+		Target:       []string{target},
+		Refresh:      waitForBaremetalServerPowerState(baremetalClient, serverID, reboot, updated),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: pollInterval,
+		MinTimeout:   10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for ECL baremetal server (%s) to become powered %s", serverID, target)
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for ECL baremetal server (%s) to become powered %s: %s", serverID, target, err)
+	}
+
+	return nil
+}
+
+func waitForBaremetalServerPowerState(baremetalClient *eclcloud.ServiceClient, serverID string, reboot bool, updated time.Time) resource.StateRefreshFunc {
+	// A reboot is only finished once the server has gone through a
+	// transition, or has been updated since the request, and become
+	// ACTIVE with its power back on.
+	transitioned := false
+	return func() (interface{}, string, error) {
+		server, err := servers.Get(baremetalClient, serverID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if server.Status == "ERROR" {
+			return server, server.Status, fmt.Errorf("ECL baremetal server (%s) went into ERROR status", serverID)
+		}
+
+		if server.TaskState != "" && server.TaskState != "None" {
+			transitioned = true
+			return server, "pending", nil
+		}
+
+		state := baremetalServerV2PowerState(server.PowerState)
+		if reboot {
+			if server.Status != "ACTIVE" || state != "on" || server.Updated.After(updated) {
+				transitioned = true
+			}
+			if !transitioned || server.Status != "ACTIVE" || state != "on" {
+				return server, "pending", nil
+			}
+		}
+
+		return server, state, nil
+	}
+}
+
 func waitForBaremetalServerDelete(baremetalClient *eclcloud.ServiceClient, serverID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] Attempting to delete ECL baremetal server %s.\n", serverID)
@@ -2,6 +2,7 @@ package ecl
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -22,6 +23,7 @@ func TestMockedBaremetalV2Server_basic(t *testing.T) {
 
 	mc.Register(t, "image", "/v2/01234567890123456789abcdefabcdef/images/detail", testMockImageV2ImageList)
 	mc.Register(t, "flavor", "/v2/01234567890123456789abcdefabcdef/flavors/detail", testMockBaremetalV2FlavorList)
+	mc.Register(t, "flavor", "/v2/01234567890123456789abcdefabcdef/flavors/flavor_id", testMockBaremetalV2FlavorGet)
 	mc.Register(t, "baremetal_server", "/v2/01234567890123456789abcdefabcdef/servers", testMockBaremetalV2ServerCreate)
 	mc.Register(t, "baremetal_server", "/v2/01234567890123456789abcdefabcdef/servers/05184ba3-00ba-4fbc-b7a2-03b62b884931", testMockBaremetalV2ServerGetAfterCreate)
 	mc.Register(t, "baremetal_server", "/v2/01234567890123456789abcdefabcdef/servers/05184ba3-00ba-4fbc-b7a2-03b62b884931", testMockBaremetalV2ServerDelete)
@@ -64,6 +66,100 @@ func TestMockedBaremetalV2Server_basic(t *testing.T) {
 	})
 }
 
+func TestMockedBaremetalV2Server_invalidLayout(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "flavor", "/v2/01234567890123456789abcdefabcdef/flavors/flavor_id", testMockBaremetalV2FlavorGet)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckBaremetal(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testMockBaremetalV2ServerInvalidLayout,
+				ExpectError: regexp.MustCompile(`raid_level 10 requires an even number of disks, got 5`),
+			},
+			resource.TestStep{
+				Config:      testMockBaremetalV2ServerExceedFlavorDisk,
+				ExpectError: regexp.MustCompile(`partitions require 600G but the flavor provides only 500G`),
+			},
+		},
+	})
+}
+
+var testMockBaremetalV2ServerInvalidLayout = `
+resource "ecl_baremetal_server_v2" "server_1" {
+    name = "server1"
+    image_id = "image_id"
+    flavor_id = "flavor_id"
+    networks {
+        uuid = "6a9a64f6-45e7-46b2-b0e8-0a850896ff55"
+        plane = "data"
+    }
+    raid_arrays {
+        primary_storage = true
+        partitions {
+            lvm = false
+            partition_label = "root"
+        }
+    }
+    raid_arrays {
+        raid_card_hardware_id = "raid_card_uuid"
+        disk_hardware_ids = ["disk1_uuid", "disk2_uuid", "disk3_uuid", "disk4_uuid", "disk5_uuid"]
+        raid_level = 10
+        partitions {
+            lvm = false
+            partition_label = "data"
+        }
+    }
+    filesystems {
+        label = "root"
+        mount_point =  "/"
+        fs_type = "xfs"
+    }
+}
+`
+
+var testMockBaremetalV2ServerExceedFlavorDisk = `
+resource "ecl_baremetal_server_v2" "server_1" {
+    name = "server1"
+    image_id = "image_id"
+    flavor_id = "flavor_id"
+    networks {
+        uuid = "6a9a64f6-45e7-46b2-b0e8-0a850896ff55"
+        plane = "data"
+    }
+    raid_arrays {
+        primary_storage = true
+        partitions {
+            lvm = false
+            size = "300G"
+            partition_label = "root"
+        }
+        partitions {
+            lvm = false
+            size = "300G"
+            partition_label = "var"
+        }
+    }
+    filesystems {
+        label = "root"
+        mount_point =  "/"
+        fs_type = "xfs"
+    }
+    filesystems {
+        label = "var"
+        mount_point =  "/var"
+        fs_type = "xfs"
+    }
+}
+`
+
 var testMockBaremetalV2ServerBasic = fmt.Sprintf(`
 resource "ecl_baremetal_server_v2" "server_1" {
     name = "server1"
@@ -399,6 +495,29 @@ response:
         }
 `
 
+var testMockBaremetalV2FlavorGet = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "flavor": {
+                "disk": 500,
+                "id": "flavor_id",
+                "links": [
+                    {
+                        "href": "<href>",
+                        "rel": "<rel>"
+                    }
+                ],
+                "name": "flavor_name",
+                "ram": 1024,
+                "vcpus": 4
+            }
+        }
+`
+
 var testMockBaremetalV2FlavorList = `
 request:
     method: GET
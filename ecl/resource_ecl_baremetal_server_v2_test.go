@@ -31,6 +31,48 @@ func TestAccBaremetalV2Server_basic(t *testing.T) {
 	})
 }
 
+func TestAccBaremetalV2Server_update(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	var server servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckBaremetal(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBaremetalV2ServerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBaremetalV2ServerPower("v1", "on", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalV2ServerExists("ecl_baremetal_server_v2.server_1", &server),
+					testAccCheckBaremetalV2ServerMetadata(&server, "k1", "v1"),
+					resource.TestCheckResourceAttr(
+						"ecl_baremetal_server_v2.server_1", "power_state", "on"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBaremetalV2ServerPower("v2", "on", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalV2ServerExists("ecl_baremetal_server_v2.server_1", &server),
+					testAccCheckBaremetalV2ServerMetadata(&server, "k1", "v2"),
+					resource.TestCheckResourceAttr(
+						"ecl_baremetal_server_v2.server_1", "power_state", "on"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBaremetalV2ServerPower("v2", "off", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalV2ServerExists("ecl_baremetal_server_v2.server_1", &server),
+					resource.TestCheckResourceAttr(
+						"ecl_baremetal_server_v2.server_1", "power_state", "off"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBaremetalV2ServerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	client, err := config.baremetalV2Client(OS_REGION_NAME)
@@ -84,6 +126,74 @@ func testAccCheckBaremetalV2ServerExists(n string, server *servers.Server) resou
 	}
 }
 
+func testAccCheckBaremetalV2ServerMetadata(server *servers.Server, k string, v string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if server.Metadata == nil {
+			return fmt.Errorf("No metadata")
+		}
+
+		if value, ok := server.Metadata[k]; ok && value == v {
+			return nil
+		}
+
+		return fmt.Errorf("Bad value for %s: %s", k, server.Metadata[k])
+	}
+}
+
+func testAccBaremetalV2ServerPower(metadataValue, powerState, rebootTrigger string) string {
+	return fmt.Sprintf(`
+data "ecl_imagestorages_image_v2" "centos" {
+    name = "CentOS-7.3-1611_64_baremetal-server_01"
+}
+
+data "ecl_baremetal_flavor_v2" "gp2" {
+    name = "General Purpose 2 v1"
+}
+
+data "ecl_baremetal_availability_zone_v2" "groupa" {
+    zone_name = "%s"
+}
+
+resource "ecl_network_network_v2" "network_1" {
+    name = "baremetal_network"
+    plane = "data"
+}
+
+resource "ecl_network_subnet_v2" "subnet_1" {
+    name = "baremetal_subnet"
+    network_id = "${ecl_network_network_v2.network_1.id}"
+    cidr = "192.168.1.0/24"
+    gateway_ip = "192.168.1.1"
+    allocation_pools {
+        start = "192.168.1.100"
+        end = "192.168.1.200"
+    }
+}
+
+resource "ecl_baremetal_server_v2" "server_1" {
+    depends_on = [
+        "ecl_network_subnet_v2.subnet_1"
+    ]
+
+    name = "server1"
+    image_id = "${data.ecl_imagestorages_image_v2.centos.id}"
+    flavor_id = "${data.ecl_baremetal_flavor_v2.gp2.id}"
+    availability_zone = "${data.ecl_baremetal_availability_zone_v2.groupa.zone_name}"
+    admin_pass = "password"
+    metadata = {
+        k1 = "%s"
+    }
+    power_state = "%s"
+    reboot_trigger = "%s"
+    networks {
+        uuid = "${ecl_network_network_v2.network_1.id}"
+        fixed_ip = "192.168.1.10"
+        plane = "data"
+    }
+}
+`, OS_BAREMETAL_ZONE, metadataValue, powerState, rebootTrigger)
+}
+
 var testAccBaremetalV2ServerBasic = fmt.Sprintf(`
 data "ecl_imagestorages_image_v2" "centos" {
     name = "CentOS-7.3-1611_64_baremetal-server_01"
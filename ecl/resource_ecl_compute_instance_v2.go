@@ -160,6 +160,33 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"scheduler_hints": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"same_host": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"different_host": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"block_device": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -315,6 +342,18 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if vL, ok := d.GetOk("scheduler_hints"); ok {
+		for _, v := range vL.([]interface{}) {
+			if v == nil {
+				continue
+			}
+			createOpts = &SchedulerHintsCreateOptsExt{
+				CreateOptsBuilder: createOpts,
+				SchedulerHints:    resourceInstanceSchedulerHintsV2(v.(map[string]interface{})),
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	// If a block_device is used, use the bootfromvolume.Create function as it allows an empty ImageRef.
@@ -765,6 +804,24 @@ func resourceInstanceMetadataV2(d *schema.ResourceData) map[string]string {
 	return m
 }
 
+func resourceInstanceSchedulerHintsV2(schedulerHintsRaw map[string]interface{}) SchedulerHints {
+	var sameHost []string
+	for _, v := range schedulerHintsRaw["same_host"].([]interface{}) {
+		sameHost = append(sameHost, v.(string))
+	}
+
+	var differentHost []string
+	for _, v := range schedulerHintsRaw["different_host"].([]interface{}) {
+		differentHost = append(differentHost, v.(string))
+	}
+
+	return SchedulerHints{
+		Group:         schedulerHintsRaw["group"].(string),
+		SameHost:      sameHost,
+		DifferentHost: differentHost,
+	}
+}
+
 func resourceInstanceBlockDevicesV2(d *schema.ResourceData, bds []interface{}) ([]bootfromvolume.BlockDevice, error) {
 	blockDeviceOpts := make([]bootfromvolume.BlockDevice, len(bds))
 	for i, bd := range bds {
@@ -469,6 +469,31 @@ func TestAccComputeV2Instance_stopBeforeDestroy(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_schedulerHints(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	var instance1, instance2 servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2InstanceSchedulerHints,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("ecl_compute_instance_v2.instance_1", &instance1),
+					testAccCheckComputeV2InstanceExists("ecl_compute_instance_v2.instance_2", &instance2),
+					resource.TestCheckResourceAttrPair(
+						"ecl_compute_instance_v2.instance_2", "scheduler_hints.0.different_host.0",
+						"ecl_compute_instance_v2.instance_1", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_metadataRemove(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skip this test in short mode")
@@ -1214,6 +1239,33 @@ resource "ecl_compute_instance_v2" "instance_1" {
 }
 `, testCreateNetworkForInstance)
 
+var testAccComputeV2InstanceSchedulerHints = fmt.Sprintf(`
+%s
+
+resource "ecl_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  image_name = "Ubuntu-18.04.1_64_virtual-server_02"
+  flavor_id = "1CPU-2GB"
+  network {
+    uuid = "${ecl_network_network_v2.network_1.id}"
+  }
+  depends_on = ["ecl_network_subnet_v2.subnet_1"]
+}
+
+resource "ecl_compute_instance_v2" "instance_2" {
+  name = "instance_2"
+  image_name = "Ubuntu-18.04.1_64_virtual-server_02"
+  flavor_id = "1CPU-2GB"
+  scheduler_hints {
+    different_host = ["${ecl_compute_instance_v2.instance_1.id}"]
+  }
+  network {
+    uuid = "${ecl_network_network_v2.network_1.id}"
+  }
+  depends_on = ["ecl_network_subnet_v2.subnet_1"]
+}
+`, testCreateNetworkForInstance)
+
 var testAccComputeV2InstanceMetadataRemove1 = fmt.Sprintf(`
 %s
 
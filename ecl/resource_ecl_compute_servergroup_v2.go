@@ -0,0 +1,121 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceComputeServerGroupV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeServerGroupV2Create,
+		Read:   resourceComputeServerGroupV2Read,
+		Delete: resourceComputeServerGroupV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:       schema.TypeString,
+				Optional:   true,
+				Computed:   true,
+				ForceNew:   true,
+				Deprecated: "This attribute is not used to set up the resource.",
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policies": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"affinity", "anti-affinity", "soft-affinity", "soft-anti-affinity",
+					}, false),
+				},
+			},
+			"members": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceComputeServerGroupV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL compute client: %s", err)
+	}
+
+	rawPolicies := d.Get("policies").([]interface{})
+	policies := make([]string, len(rawPolicies))
+	for i, p := range rawPolicies {
+		policies[i] = p.(string)
+	}
+
+	createOpts := ServerGroupCreateOpts{
+		Name:     d.Get("name").(string),
+		Policies: policies,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	sg, err := computeServerGroupV2Create(computeClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating ECL server group: %s", err)
+	}
+
+	d.SetId(sg.ID)
+
+	return resourceComputeServerGroupV2Read(d, meta)
+}
+
+func resourceComputeServerGroupV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL compute client: %s", err)
+	}
+
+	sg, err := computeServerGroupV2Get(computeClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "server group")
+	}
+
+	log.Printf("[DEBUG] Retrieved Server Group %s: %+v", d.Id(), sg)
+
+	d.Set("name", sg.Name)
+	d.Set("policies", sg.Policies)
+	d.Set("members", sg.Members)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceComputeServerGroupV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL compute client: %s", err)
+	}
+
+	err = computeServerGroupV2Delete(computeClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "server group")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,156 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/nttcom/eclcloud/v3/ecl/compute/v2/servers"
+)
+
+func TestAccComputeV2ServerGroup_basic(t *testing.T) {
+	var sg ServerGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2ServerGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2ServerGroupBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2ServerGroupExists("ecl_compute_servergroup_v2.sg_1", &sg),
+					resource.TestCheckResourceAttr(
+						"ecl_compute_servergroup_v2.sg_1", "name", "sg_1"),
+					resource.TestCheckResourceAttr(
+						"ecl_compute_servergroup_v2.sg_1", "policies.0", "affinity"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2ServerGroup_antiAffinity(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	var sg ServerGroup
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2ServerGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2ServerGroupAntiAffinity,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2ServerGroupExists("ecl_compute_servergroup_v2.sg_1", &sg),
+					testAccCheckComputeV2InstanceExists("ecl_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceInServerGroup(&instance, &sg),
+					resource.TestCheckResourceAttr(
+						"ecl_compute_servergroup_v2.sg_1", "policies.0", "anti-affinity"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2ServerGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	computeClient, err := config.computeV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating ECL compute client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ecl_compute_servergroup_v2" {
+			continue
+		}
+
+		_, err := computeServerGroupV2Get(computeClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("ServerGroup still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeV2ServerGroupExists(n string, sg *ServerGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating ECL compute client: %s", err)
+		}
+
+		found, err := computeServerGroupV2Get(computeClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("ServerGroup not found")
+		}
+
+		*sg = *found
+
+		return nil
+	}
+}
+
+func testAccCheckComputeV2InstanceInServerGroup(instance *servers.Server, sg *ServerGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(sg.Members) > 0 {
+			for _, m := range sg.Members {
+				if m == instance.ID {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Instance %s is not part of Server Group %s", instance.ID, sg.ID)
+	}
+}
+
+const testAccComputeV2ServerGroupBasic = `
+resource "ecl_compute_servergroup_v2" "sg_1" {
+  name = "sg_1"
+  policies = ["affinity"]
+}
+`
+
+var testAccComputeV2ServerGroupAntiAffinity = fmt.Sprintf(`
+%s
+
+resource "ecl_compute_servergroup_v2" "sg_1" {
+  name = "sg_1"
+  policies = ["anti-affinity"]
+}
+
+resource "ecl_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  image_name = "Ubuntu-18.04.1_64_virtual-server_02"
+  flavor_id = "1CPU-2GB"
+  scheduler_hints {
+    group = "${ecl_compute_servergroup_v2.sg_1.id}"
+  }
+  network {
+    uuid = "${ecl_network_network_v2.network_1.id}"
+  }
+  depends_on = ["ecl_network_subnet_v2.subnet_1"]
+}
+`, testCreateNetworkForInstance)
@@ -3,6 +3,7 @@ package ecl
 import (
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/validation"
@@ -20,14 +21,17 @@ func resourceDedicatedHypervisorServerV1() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDedicatedHypervisorServerV1Create,
 		Read:   resourceDedicatedHypervisorServerV1Read,
+		Update: resourceDedicatedHypervisorServerV1Update,
 		Delete: resourceDedicatedHypervisorServerV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceDedicatedHypervisorServerV1Import,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
+		CustomizeDiff: resourceDedicatedHypervisorServerV1CustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -104,6 +108,36 @@ func resourceDedicatedHypervisorServerV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"licenses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vm_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vm_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"license_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"licenses_untracked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -150,6 +184,11 @@ func resourceDedicatedHypervisorServerV1Create(d *schema.ResourceData, meta inte
 		return fmt.Errorf("error waiting for Dedicated Hypervisor sever (%s) to become ready: %s", server.ID, err)
 	}
 
+	if added, err := addDedicatedHypervisorServerV1Licenses(client, server.ID, nil, d.Get("licenses").([]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.Set("licenses", added)
+		return err
+	}
+
 	log.Printf("[DEBUG] Created ECL Dedicated Hypervisor server %s: %#v", server.ID, server)
 	return resourceDedicatedHypervisorServerV1Read(d, meta)
 }
@@ -179,6 +218,39 @@ func resourceDedicatedHypervisorServerV1Read(d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceDedicatedHypervisorServerV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.dedicatedHypervisorV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL Dedicated Hypervisor client: %s", err)
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("licenses") {
+		o, n := d.GetChange("licenses")
+		if added, err := addDedicatedHypervisorServerV1Licenses(client, d.Id(), o.([]interface{}), n.([]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			// Keep the licenses which have been added so that
+			// they are not added again by the next apply.
+			d.Set("licenses", added)
+			d.SetPartial("licenses")
+			return err
+		}
+		d.SetPartial("licenses")
+	}
+
+	d.Partial(false)
+
+	return resourceDedicatedHypervisorServerV1Read(d, meta)
+}
+
+// resourceDedicatedHypervisorServerV1Import marks the licenses of the server
+// as untracked, because added licenses can not be read back from the API.
+func resourceDedicatedHypervisorServerV1Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("licenses_untracked", true)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceDedicatedHypervisorServerV1Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	client, err := config.dedicatedHypervisorV1Client(GetRegion(d, config))
@@ -249,3 +321,155 @@ func resourceDedicatedHypervisorMetadataV1(d *schema.ResourceData) map[string]st
 	}
 	return m
 }
+
+// resourceDedicatedHypervisorServerV1CustomizeDiff rejects licenses without a
+// target guest and the removal of licenses, which the API does not support.
+// Licenses are also rejected for imported servers, whose licenses are not
+// known and would be added again.
+func resourceDedicatedHypervisorServerV1CustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	for i, raw := range d.Get("licenses").([]interface{}) {
+		m := raw.(map[string]interface{})
+		if m["vm_name"].(string) == "" && m["vm_id"].(string) == "" {
+			if d.NewValueKnown(fmt.Sprintf("licenses.%d.vm_name", i)) && d.NewValueKnown(fmt.Sprintf("licenses.%d.vm_id", i)) {
+				return fmt.Errorf("licenses.%d: either vm_name or vm_id must be specified", i)
+			}
+		}
+	}
+
+	if d.Id() == "" || !d.HasChange("licenses") {
+		return nil
+	}
+
+	if d.Get("licenses_untracked").(bool) {
+		return fmt.Errorf("licenses of the imported Dedicated Hypervisor server (%s) are not tracked, remove licenses or add it to ignore_changes", d.Id())
+	}
+
+	o, n := d.GetChange("licenses")
+	oldCounts := resourceDedicatedHypervisorLicenseCountsV1(o.([]interface{}))
+	newCounts := resourceDedicatedHypervisorLicenseCountsV1(n.([]interface{}))
+	for k, count := range oldCounts {
+		if newCounts[k] < count {
+			return fmt.Errorf("licenses can not be removed from a Dedicated Hypervisor guest: %s", k)
+		}
+	}
+
+	return nil
+}
+
+type dedicatedHypervisorLicenseTargetV1 struct {
+	VmName      string
+	VmID        string
+	LicenseType string
+}
+
+func (t dedicatedHypervisorLicenseTargetV1) String() string {
+	vm := t.VmID
+	if vm == "" {
+		vm = t.VmName
+	}
+	return fmt.Sprintf("%s (%s)", t.LicenseType, vm)
+}
+
+// resourceDedicatedHypervisorLicenseCountsV1 sums up the count of licenses
+// by guest and license type.
+func resourceDedicatedHypervisorLicenseCountsV1(raw []interface{}) map[dedicatedHypervisorLicenseTargetV1]int {
+	counts := make(map[dedicatedHypervisorLicenseTargetV1]int)
+	for _, i := range raw {
+		m := i.(map[string]interface{})
+		t := dedicatedHypervisorLicenseTargetV1{
+			VmName:      m["vm_name"].(string),
+			VmID:        m["vm_id"].(string),
+			LicenseType: m["license_type"].(string),
+		}
+		counts[t] += m["count"].(int)
+	}
+	return counts
+}
+
+// addDedicatedHypervisorServerV1Licenses adds the licenses which are in n but
+// not in o, and waits for every add license job to complete. It returns o and
+// the licenses which have been added, also when a later job fails.
+func addDedicatedHypervisorServerV1Licenses(client *eclcloud.ServiceClient, serverID string, o, n []interface{}, timeout time.Duration) ([]interface{}, error) {
+	added := append([]interface{}{}, o...)
+
+	oldCounts := resourceDedicatedHypervisorLicenseCountsV1(o)
+	newCounts := resourceDedicatedHypervisorLicenseCountsV1(n)
+
+	// Add the licenses in a stable order.
+	targets := make([]dedicatedHypervisorLicenseTargetV1, 0, len(newCounts))
+	for t := range newCounts {
+		targets = append(targets, t)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].String() < targets[j].String()
+	})
+
+	for _, t := range targets {
+		count := newCounts[t] - oldCounts[t]
+		if count <= 0 {
+			continue
+		}
+
+		licenseTypes := make([]string, count)
+		for i := range licenseTypes {
+			licenseTypes[i] = t.LicenseType
+		}
+
+		opts := servers.AddLicenseOpts{
+			VmName:       t.VmName,
+			VmID:         t.VmID,
+			LicenseTypes: licenseTypes,
+		}
+
+		log.Printf("[DEBUG] Add License Options: %#v", opts)
+		job, err := servers.AddLicense(client, serverID, opts).Extract()
+		if err != nil {
+			return added, fmt.Errorf("error adding license %s to ECL Dedicated Hypervisor server (%s): %s", t, serverID, err)
+		}
+
+		log.Printf("[DEBUG] Waiting for add license job (%s) of Dedicated Hypervisor server (%s) to complete", job.JobID, serverID)
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"PENDING"},
+			Target:     []string{"COMPLETED"},
+			Refresh:    DedicatedHypervisorServerV1AddLicenseRefreshFunc(client, serverID, job.JobID),
+			Timeout:    timeout,
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		if _, err = stateConf.WaitForState(); err != nil {
+			return added, fmt.Errorf("error waiting for license %s to be added to ECL Dedicated Hypervisor server (%s): %s", t, serverID, err)
+		}
+
+		added = append(added, map[string]interface{}{
+			"vm_name":      t.VmName,
+			"vm_id":        t.VmID,
+			"license_type": t.LicenseType,
+			"count":        count,
+		})
+	}
+
+	return added, nil
+}
+
+func DedicatedHypervisorServerV1AddLicenseRefreshFunc(client *eclcloud.ServiceClient, serverID, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		opts := servers.GetAddLicenseResultOpts{
+			JobID: jobID,
+		}
+
+		job, err := servers.GetAddLicenseResult(client, serverID, opts).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch job.Status {
+		case "COMPLETED":
+			return job, job.Status, nil
+		case "FAILED", "ERROR":
+			return job, job.Status, fmt.Errorf("add license job (%s) finished with status %s", jobID, job.Status)
+		}
+
+		return job, "PENDING", nil
+	}
+}
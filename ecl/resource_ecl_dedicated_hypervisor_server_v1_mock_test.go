@@ -2,6 +2,7 @@ package ecl
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/nttcom/eclcloud/v3/ecl/dedicated_hypervisor/v1/servers"
@@ -300,3 +301,325 @@ response:
 expectedStatus:
     - Deleted
 `
+
+func TestMockedDedicatedHypervisorV1Server_licenses(t *testing.T) {
+	var server servers.Server
+
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers", testMockDedicatedHypervisorV1ServerCreate)
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5", testMockDedicatedHypervisorV1ServerGetAfterCreate)
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5/action", testMockDedicatedHypervisorV1ServerAddLicenseWindows)
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5/action", testMockDedicatedHypervisorV1ServerAddLicenseSQL)
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5/action", testMockDedicatedHypervisorV1ServerGetAddLicenseResult)
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5", testMockDedicatedHypervisorV1ServerDelete)
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5", testMockDedicatedHypervisorV1ServerGetAfterDelete)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDedicatedHypervisor(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedHypervisorV1ServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockDedicatedHypervisorV1ServerLicenses,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedHypervisorV1ServerExists("ecl_dedicated_hypervisor_server_v1.server_1", &server),
+					resource.TestCheckResourceAttr("ecl_dedicated_hypervisor_server_v1.server_1", "licenses.#", "1"),
+					resource.TestCheckResourceAttr("ecl_dedicated_hypervisor_server_v1.server_1", "licenses.0.vm_name", "vm1"),
+					resource.TestCheckResourceAttr("ecl_dedicated_hypervisor_server_v1.server_1", "licenses.0.license_type", "Windows Server"),
+					resource.TestCheckResourceAttr("ecl_dedicated_hypervisor_server_v1.server_1", "licenses.0.count", "2"),
+				),
+			},
+			{
+				Config: testMockDedicatedHypervisorV1ServerLicensesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedHypervisorV1ServerExists("ecl_dedicated_hypervisor_server_v1.server_1", &server),
+					resource.TestCheckResourceAttr("ecl_dedicated_hypervisor_server_v1.server_1", "licenses.#", "2"),
+					resource.TestCheckResourceAttr("ecl_dedicated_hypervisor_server_v1.server_1", "licenses.1.vm_name", "vm1"),
+					resource.TestCheckResourceAttr("ecl_dedicated_hypervisor_server_v1.server_1", "licenses.1.license_type", "SQL Server Standard 2014"),
+					resource.TestCheckResourceAttr("ecl_dedicated_hypervisor_server_v1.server_1", "licenses.1.count", "1"),
+				),
+			},
+			{
+				Config:      testMockDedicatedHypervisorV1ServerLicensesRemove,
+				ExpectError: regexp.MustCompile("licenses can not be removed from a Dedicated Hypervisor guest"),
+			},
+		},
+	})
+}
+
+var testMockDedicatedHypervisorV1ServerLicensesTmpl = `
+resource "ecl_dedicated_hypervisor_server_v1" "server_1" {
+    name = "server1"
+    description = "ESXi Dedicated Hypervisor"
+    networks {
+        uuid = "94055904-6b2c-4839-a14a-c61c93a8bc48"
+        fixed_ip = "192.168.1.10"
+        plane = "data"
+        segmentation_id = 4
+    }
+    networks {
+        uuid = "94055904-6b2c-4839-a14a-c61c93a8bc48"
+        fixed_ip = "192.168.1.11"
+        plane = "data"
+        segmentation_id = 4
+    }
+    admin_pass = "aabbccddeeff"
+    image_ref = "dfd25820-b368-4012-997b-29a6d0cf8518"
+    flavor_ref = "a830b61c-3155-4a61-b7ed-c450862845e6"
+    availability_zone = "%s"
+    metadata = {
+        k1 = "v1"
+        k2 = "v2"
+    }
+%s
+}
+`
+
+var testMockDedicatedHypervisorV1ServerLicenses = fmt.Sprintf(testMockDedicatedHypervisorV1ServerLicensesTmpl, OS_BAREMETAL_ZONE, `
+    licenses {
+        vm_name = "vm1"
+        license_type = "Windows Server"
+        count = 2
+    }
+`)
+
+var testMockDedicatedHypervisorV1ServerLicensesUpdate = fmt.Sprintf(testMockDedicatedHypervisorV1ServerLicensesTmpl, OS_BAREMETAL_ZONE, `
+    licenses {
+        vm_name = "vm1"
+        license_type = "Windows Server"
+        count = 2
+    }
+    licenses {
+        vm_name = "vm1"
+        license_type = "SQL Server Standard 2014"
+    }
+`)
+
+var testMockDedicatedHypervisorV1ServerLicensesRemove = fmt.Sprintf(testMockDedicatedHypervisorV1ServerLicensesTmpl, OS_BAREMETAL_ZONE, `
+    licenses {
+        vm_name = "vm1"
+        license_type = "Windows Server"
+        count = 1
+    }
+`)
+
+var testMockDedicatedHypervisorV1ServerAddLicenseWindows = `
+request:
+    method: POST
+    body: >
+        {"add-license-to-vm":{"license_types":["Windows Server","Windows Server"],"vm_name":"vm1"}}
+response:
+    code: 200
+    body: >
+        {
+            "job_id": "b4f888dc2b9d4c41bb769cbd"
+        }
+`
+
+var testMockDedicatedHypervisorV1ServerAddLicenseSQL = `
+request:
+    method: POST
+    body: >
+        {"add-license-to-vm":{"license_types":["SQL Server Standard 2014"],"vm_name":"vm1"}}
+response:
+    code: 200
+    body: >
+        {
+            "job_id": "b4f888dc2b9d4c41bb769cbd"
+        }
+`
+
+var testMockDedicatedHypervisorV1ServerGetAddLicenseResult = `
+request:
+    method: POST
+    body: >
+        {"get-result-for-add-license-to-vm":{"job_id":"b4f888dc2b9d4c41bb769cbd"}}
+response:
+    code: 200
+    body: >
+        {
+            "job_id": "b4f888dc2b9d4c41bb769cbd",
+            "status": "COMPLETED",
+            "requested_param": {
+                "vm_name": "vm1",
+                "license_types": [
+                    "Windows Server"
+                ]
+            }
+        }
+`
+
+func TestMockedDedicatedHypervisorV1Server_licensesPartialFailure(t *testing.T) {
+	var server servers.Server
+
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers", testMockDedicatedHypervisorV1ServerCreate)
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5", testMockDedicatedHypervisorV1ServerGetAfterCreate)
+	mc.Register(t, "licenses", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5/action", testMockDedicatedHypervisorV1ServerAddLicenseSQLOnce)
+	mc.Register(t, "licenses", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5/action", testMockDedicatedHypervisorV1ServerGetAddLicenseResultSQL)
+	mc.Register(t, "licenses", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5/action", testMockDedicatedHypervisorV1ServerAddLicenseWindowsFirst)
+	mc.Register(t, "licenses", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5/action", testMockDedicatedHypervisorV1ServerGetAddLicenseResultWindowsFailed)
+	mc.Register(t, "licenses", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5/action", testMockDedicatedHypervisorV1ServerAddLicenseWindowsRetry)
+	mc.Register(t, "licenses", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5/action", testMockDedicatedHypervisorV1ServerGetAddLicenseResultWindows)
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5", testMockDedicatedHypervisorV1ServerDelete)
+	mc.Register(t, "dedicated_hypervisor", "/v1.0/1bc271e7a8af4d988ff91612f5b122f8/servers/f42dbc37-4642-4628-8b47-50bf95d8fdd5", testMockDedicatedHypervisorV1ServerGetAfterDelete)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDedicatedHypervisor(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDedicatedHypervisorV1ServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testMockDedicatedHypervisorV1ServerLicensesTmpl, OS_BAREMETAL_ZONE, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedHypervisorV1ServerExists("ecl_dedicated_hypervisor_server_v1.server_1", &server),
+					resource.TestCheckResourceAttr("ecl_dedicated_hypervisor_server_v1.server_1", "licenses.#", "0"),
+				),
+			},
+			{
+				// The SQL Server license is added before the Windows Server one fails.
+				Config:      testMockDedicatedHypervisorV1ServerLicensesUpdate,
+				ExpectError: regexp.MustCompile("finished with status FAILED"),
+			},
+			{
+				// Only the Windows Server license is added again.
+				Config: testMockDedicatedHypervisorV1ServerLicensesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ecl_dedicated_hypervisor_server_v1.server_1", "licenses.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+var testMockDedicatedHypervisorV1ServerAddLicenseSQLOnce = `
+request:
+    method: POST
+    body: >
+        {"add-license-to-vm":{"license_types":["SQL Server Standard 2014"],"vm_name":"vm1"}}
+response:
+    code: 200
+    body: >
+        {
+            "job_id": "0c4f4e9f3a7e4b5e8f1a2b3c"
+        }
+expectedStatus:
+    - ""
+newStatus: SQLRequested
+`
+
+var testMockDedicatedHypervisorV1ServerGetAddLicenseResultSQL = `
+request:
+    method: POST
+    body: >
+        {"get-result-for-add-license-to-vm":{"job_id":"0c4f4e9f3a7e4b5e8f1a2b3c"}}
+response:
+    code: 200
+    body: >
+        {
+            "job_id": "0c4f4e9f3a7e4b5e8f1a2b3c",
+            "status": "COMPLETED",
+            "requested_param": {
+                "vm_name": "vm1",
+                "license_types": [
+                    "SQL Server Standard 2014"
+                ]
+            }
+        }
+expectedStatus:
+    - SQLRequested
+newStatus: SQLAdded
+`
+
+var testMockDedicatedHypervisorV1ServerAddLicenseWindowsFirst = `
+request:
+    method: POST
+    body: >
+        {"add-license-to-vm":{"license_types":["Windows Server","Windows Server"],"vm_name":"vm1"}}
+response:
+    code: 200
+    body: >
+        {
+            "job_id": "b4f888dc2b9d4c41bb769cbd"
+        }
+expectedStatus:
+    - SQLAdded
+newStatus: WindowsRequested
+`
+
+var testMockDedicatedHypervisorV1ServerGetAddLicenseResultWindowsFailed = `
+request:
+    method: POST
+    body: >
+        {"get-result-for-add-license-to-vm":{"job_id":"b4f888dc2b9d4c41bb769cbd"}}
+response:
+    code: 200
+    body: >
+        {
+            "job_id": "b4f888dc2b9d4c41bb769cbd",
+            "status": "FAILED",
+            "requested_param": {
+                "vm_name": "vm1",
+                "license_types": [
+                    "Windows Server",
+                    "Windows Server"
+                ]
+            }
+        }
+expectedStatus:
+    - WindowsRequested
+newStatus: WindowsFailed
+`
+
+var testMockDedicatedHypervisorV1ServerAddLicenseWindowsRetry = `
+request:
+    method: POST
+    body: >
+        {"add-license-to-vm":{"license_types":["Windows Server","Windows Server"],"vm_name":"vm1"}}
+response:
+    code: 200
+    body: >
+        {
+            "job_id": "b4f888dc2b9d4c41bb769cbd"
+        }
+expectedStatus:
+    - WindowsFailed
+newStatus: WindowsRetried
+`
+
+var testMockDedicatedHypervisorV1ServerGetAddLicenseResultWindows = `
+request:
+    method: POST
+    body: >
+        {"get-result-for-add-license-to-vm":{"job_id":"b4f888dc2b9d4c41bb769cbd"}}
+response:
+    code: 200
+    body: >
+        {
+            "job_id": "b4f888dc2b9d4c41bb769cbd",
+            "status": "COMPLETED",
+            "requested_param": {
+                "vm_name": "vm1",
+                "license_types": [
+                    "Windows Server",
+                    "Windows Server"
+                ]
+            }
+        }
+expectedStatus:
+    - WindowsRetried
+`
@@ -0,0 +1,263 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/recordsets"
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/zones"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceDNSPTRRecordV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSPTRRecordV2Create,
+		Read:   resourceDNSPTRRecordV2Read,
+		Update: resourceDNSPTRRecordV2Update,
+		Delete: resourceDNSPTRRecordV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip_address": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
+			"ptrdname": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDNSPTRRecordV2PTRDName,
+				DiffSuppressFunc: suppressDNSPTRRecordV2PTRDNameDiffs,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSPTRRecordV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	address := d.Get("ip_address").(string)
+	ip := net.ParseIP(address).To4()
+	if ip == nil {
+		return fmt.Errorf("%q is not a valid IPv4 address", address)
+	}
+
+	var name string
+	zoneID := d.Get("zone_id").(string)
+	if zoneID == "" {
+		zone, n, err := dnsReverseZoneV2Find(dnsClient, ip)
+		if err != nil {
+			return err
+		}
+		zoneID = zone.ID
+		name = n
+	} else {
+		zone, err := zones.Get(dnsClient, zoneID).Extract()
+		if err != nil {
+			return fmt.Errorf("Error retrieving ECL DNS zone %s: %s", zoneID, err)
+		}
+
+		n, _, ok := dnsReverseZoneV2RecordName(zone.Name, ip)
+		if !ok {
+			return fmt.Errorf("ECL DNS zone %s does not contain %s", zone.Name, address)
+		}
+		name = n
+	}
+
+	createOpts := RecordSetCreateOpts{
+		recordsets.CreateOpts{
+			Name:        name,
+			Description: d.Get("description").(string),
+			Records:     []string{dnsRecordSetV2Qualify(d.Get("ptrdname").(string), "")},
+			TTL:         d.Get("ttl").(int),
+			Type:        "PTR",
+		},
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	n, err := recordsets.Create(dnsClient, zoneID, createOpts).ExtractCreatedRecordSet()
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS PTR record: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", zoneID, n.ID))
+
+	// ECL2.0 DNS API returns 404(Not Found) just after creation.
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := recordsets.Get(dnsClient, zoneID, n.ID).Extract()
+		if err != nil {
+			if _, ok := err.(eclcloud.ErrDefault404); ok {
+				log.Printf("[DEBUG] Waiting for DNS PTR record (%s) to be created", n.ID)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Some error occurred in getting ECL DNS PTR record: %s", err)
+	}
+
+	log.Printf("[DEBUG] Created ECL DNS PTR record %s: %#v", n.ID, n)
+	return resourceDNSPTRRecordV2Read(d, meta)
+}
+
+func resourceDNSPTRRecordV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	zoneID, recordsetID, err := parseDNSV2RecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	n, err := recordsets.Get(dnsClient, zoneID, recordsetID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "ptr_record")
+	}
+
+	if !strings.EqualFold(n.Type, "PTR") {
+		return fmt.Errorf("ECL DNS record set %s is not a PTR record but %s", recordsetID, n.Type)
+	}
+
+	address, err := dnsReverseZoneV2Address(n.Name)
+	if err != nil {
+		return err
+	}
+
+	d.Set("ip_address", address)
+	d.Set("name", n.Name)
+	d.Set("zone_id", zoneID)
+	d.Set("ttl", n.TTL)
+	d.Set("description", n.Description)
+	if records := dnsRecordSetV2Records(n); len(records) > 0 {
+		d.Set("ptrdname", records[0])
+	}
+
+	return nil
+}
+
+func resourceDNSPTRRecordV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	zoneID, recordsetID, err := parseDNSV2RecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// All parameters are sent, see resourceDNSRecordSetV2Update.
+	name := d.Get("name").(string)
+	ttl := d.Get("ttl").(int)
+	records := []string{dnsRecordSetV2Qualify(d.Get("ptrdname").(string), "")}
+	description := d.Get("description").(string)
+	updateOpts := recordsets.UpdateOpts{
+		Name:        &name,
+		TTL:         &ttl,
+		Records:     &records,
+		Description: &description,
+	}
+
+	log.Printf("[DEBUG] Updating PTR record %s with options: %#v", recordsetID, updateOpts)
+	_, err = recordsets.Update(dnsClient, zoneID, recordsetID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating ECL DNS PTR record: %s", err)
+	}
+
+	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		rs, err := recordsets.Get(dnsClient, zoneID, recordsetID).Extract()
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		actual := dnsRecordSetV2Records(rs)
+		if rs.TTL != ttl || len(actual) != 1 || !strings.EqualFold(actual[0], records[0]) {
+			log.Printf("[DEBUG] Waiting for DNS PTR record (%s) to be updated", recordsetID)
+			return resource.RetryableError(fmt.Errorf("PTR record %s is not updated yet", recordsetID))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Some error occurred in getting ECL DNS PTR record: %s", err)
+	}
+
+	return resourceDNSPTRRecordV2Read(d, meta)
+}
+
+func resourceDNSPTRRecordV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	zoneID, recordsetID, err := parseDNSV2RecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = recordsets.Delete(dnsClient, zoneID, recordsetID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "ptr_record")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func validateDNSPTRRecordV2PTRDName(v interface{}, k string) (ws []string, errors []error) {
+	if err := dnsRecordSetV2ValidateHostname(v.(string), false); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+// suppressDNSPTRRecordV2PTRDNameDiffs suppresses diffs of a missing
+// trailing dot or of the case of ptrdname.
+func suppressDNSPTRRecordV2PTRDNameDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(dnsRecordSetV2Qualify(old, ""), dnsRecordSetV2Qualify(new, ""))
+}
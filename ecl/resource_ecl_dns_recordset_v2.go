@@ -9,7 +9,9 @@ import (
 
 	"github.com/nttcom/eclcloud/v3"
 	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/recordsets"
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/zones"
 
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
@@ -30,6 +32,17 @@ func resourceDNSRecordSetV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceDNSRecordSetV2CustomizeDiff,
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceDNSRecordSetV2V0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceDNSRecordSetV2StateUpgradeV0,
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -37,8 +50,10 @@ func resourceDNSRecordSetV2() *schema.Resource {
 				ForceNew: true,
 			},
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDNSRecordSetV2Name,
+				DiffSuppressFunc: suppressDNSRecordSetV2NameDiffs,
 			},
 			"description": &schema.Schema{
 				Type:     schema.TypeString,
@@ -57,6 +72,54 @@ func resourceDNSRecordSetV2() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"record": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "Use records instead",
+				ConflictsWith: []string{"records"},
+			},
+			"records": &schema.Schema{
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"record"},
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           dnsRecordSetV2RecordHash,
+			},
+			"zone_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceDNSRecordSetV2V0 is the schema of ecl_dns_recordset_v2 before
+// records was introduced.
+func resourceDNSRecordSetV2V0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
 			"record": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
@@ -65,6 +128,15 @@ func resourceDNSRecordSetV2() *schema.Resource {
 	}
 }
 
+// resourceDNSRecordSetV2StateUpgradeV0 copies the single record into records.
+func resourceDNSRecordSetV2StateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if record, ok := rawState["record"].(string); ok && record != "" {
+		rawState["records"] = []interface{}{record}
+	}
+
+	return rawState, nil
+}
+
 func resourceDNSRecordSetV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
@@ -72,10 +144,20 @@ func resourceDNSRecordSetV2Create(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error creating ECL DNS client: %s", err)
 	}
 
-	records := []string{d.Get("record").(string)}
+	zoneName, err := resourceDNSRecordSetV2ZoneName(d, dnsClient)
+	if err != nil {
+		return err
+	}
+	d.Set("zone_name", zoneName)
+
+	records := resourceDNSRecordSetV2RecordsFromConfig(d)
+	if len(records) == 0 {
+		return fmt.Errorf("Either record or records must be specified")
+	}
+
 	createOpts := RecordSetCreateOpts{
 		recordsets.CreateOpts{
-			Name:        d.Get("name").(string),
+			Name:        dnsRecordSetV2Qualify(d.Get("name").(string), zoneName),
 			Description: d.Get("description").(string),
 			Records:     records,
 			TTL:         d.Get("ttl").(int),
@@ -142,14 +224,26 @@ func resourceDNSRecordSetV2Read(d *schema.ResourceData, meta interface{}) error
 		return CheckDeleted(d, err, "record_set")
 	}
 
-	records := n.Records.([]interface{})
-	record := records[0].(string)
+	if d.Get("zone_name").(string) == "" {
+		zoneName, err := resourceDNSRecordSetV2ZoneName(d, dnsClient)
+		if err != nil {
+			return err
+		}
+		d.Set("zone_name", zoneName)
+	}
+
+	records := dnsRecordSetV2Records(n)
 
 	d.Set("name", n.Name)
 	d.Set("description", n.Description)
 	d.Set("ttl", n.TTL)
 	d.Set("type", n.Type)
-	d.Set("record", record)
+	if len(records) > 0 {
+		d.Set("record", records[0])
+	}
+	if err := d.Set("records", records); err != nil {
+		return fmt.Errorf("Unable to set records: %s", err)
+	}
 	d.Set("zone_id", zoneID)
 
 	return nil
@@ -170,13 +264,18 @@ func resourceDNSRecordSetV2Update(d *schema.ResourceData, meta interface{}) erro
 	// Error -> E2044 At least domain name or TTL or recordset value is required.
 	// So in RecordSet case, all parameter you can pudate will be sent as request body.
 	// This is why this resource does not use "d.HasChange(<param name>)".
-	name := d.Get("name").(string)
+	zoneName, err := resourceDNSRecordSetV2ZoneName(d, dnsClient)
+	if err != nil {
+		return err
+	}
+
+	name := dnsRecordSetV2Qualify(d.Get("name").(string), zoneName)
 	updateOpts.Name = &name
 
 	ttl := d.Get("ttl").(int)
 	updateOpts.TTL = &ttl
 
-	records := []string{d.Get("record").(string)}
+	records := resourceDNSRecordSetV2RecordsFromConfig(d)
 	updateOpts.Records = &records
 
 	description := d.Get("description").(string)
@@ -226,17 +325,213 @@ func isRecordSetUpdated(d *schema.ResourceData, rs *recordsets.RecordSet) bool {
 		return false
 	}
 
-	if d.HasChange("record") {
-		records := []string{d.Get("record").(string)}
-		realRecord := rs.Records.([]interface{})[0].(string)
-		if records[0] != realRecord {
-			log.Printf("[DEBUG] Record still does not match.")
+	if d.HasChange("record") || d.HasChange("records") {
+		expected := schema.NewSet(dnsRecordSetV2RecordHash, nil)
+		for _, r := range resourceDNSRecordSetV2RecordsFromConfig(d) {
+			expected.Add(r)
+		}
+		actual := schema.NewSet(dnsRecordSetV2RecordHash, nil)
+		for _, r := range dnsRecordSetV2Records(rs) {
+			actual.Add(r)
+		}
+		if !expected.Equal(actual) {
+			log.Printf("[DEBUG] Records still do not match.")
 			return false
 		}
 	}
 	return true
 }
 
+// resourceDNSRecordSetV2RecordsFromConfig returns the records to send to the API.
+// record and records are both computed, so whichever of them has been changed
+// in the configuration is the one in use.
+func resourceDNSRecordSetV2RecordsFromConfig(d *schema.ResourceData) []string {
+	var records []string
+	if d.Id() == "" || d.HasChange("record") {
+		if record := d.Get("record").(string); record != "" {
+			records = []string{record}
+		}
+	}
+	if records == nil {
+		records = formatDNSV2Records(d.Get("records").(*schema.Set).List())
+	}
+
+	rrtype := d.Get("type").(string)
+	zoneName := d.Get("zone_name").(string)
+	for i, r := range records {
+		records[i] = dnsRecordSetV2NormalizeRecord(rrtype, r, zoneName)
+	}
+
+	return records
+}
+
+// resourceDNSRecordSetV2ZoneName returns the name of the zone of the record set.
+func resourceDNSRecordSetV2ZoneName(d *schema.ResourceData, client *eclcloud.ServiceClient) (string, error) {
+	if v := d.Get("zone_name").(string); v != "" {
+		return v, nil
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	if d.Id() != "" {
+		zoneID, _, _ = parseDNSV2RecordSetID(d.Id())
+	}
+
+	zone, err := zones.Get(client, zoneID).Extract()
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving ECL DNS zone %s: %s", zoneID, err)
+	}
+
+	return zone.Name, nil
+}
+
+// resourceDNSRecordSetV2CustomizeDiff validates the records against the type
+// of the record set, normalizes them relative to the zone, and checks
+// that a CNAME record does not coexist with other records of the same name.
+func resourceDNSRecordSetV2CustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+	rrtype := strings.ToUpper(d.Get("type").(string))
+
+	config := meta.(*Config)
+	var dnsClient *eclcloud.ServiceClient
+
+	zoneName := d.Get("zone_name").(string)
+	if zoneName == "" && d.NewValueKnown("zone_id") {
+		var err error
+		dnsClient, err = config.dnsV2Client(config.Region)
+		if err != nil {
+			return fmt.Errorf("Error creating ECL DNS client: %s", err)
+		}
+
+		zoneID := d.Get("zone_id").(string)
+		zone, err := zones.Get(dnsClient, zoneID).Extract()
+		if err != nil {
+			return fmt.Errorf("Error retrieving ECL DNS zone %s: %s", zoneID, err)
+		}
+
+		zoneName = zone.Name
+		if err := d.SetNew("zone_name", zoneName); err != nil {
+			return err
+		}
+	}
+
+	key := "records"
+	if d.Id() == "" || d.HasChange("record") {
+		if d.Get("record").(string) != "" {
+			key = "record"
+		}
+	}
+
+	if d.NewValueKnown(key) {
+		var records []string
+		if key == "record" {
+			records = []string{d.Get("record").(string)}
+		} else {
+			records = formatDNSV2Records(d.Get("records").(*schema.Set).List())
+		}
+
+		if rrtype == "CNAME" && len(records) > 1 {
+			return fmt.Errorf("A CNAME record set can only have a single record")
+		}
+
+		changed := false
+		normalized := make([]interface{}, len(records))
+		for i, r := range records {
+			if err := dnsRecordSetV2ValidateRecord(rrtype, r); err != nil {
+				return fmt.Errorf("%s: %s", key, err)
+			}
+			n := dnsRecordSetV2NormalizeRecord(rrtype, r, zoneName)
+			changed = changed || n != r
+			normalized[i] = n
+		}
+
+		// Domain names are relative to the zone, whose name is not known
+		// yet when the zone is created in the same apply.
+		if zoneName == "" && dnsRecordSetV2HasDomainName(rrtype) {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		} else if changed {
+			log.Printf("[DEBUG] Normalized %s of DNS record set: %#v", key, normalized)
+			var err error
+			if key == "record" {
+				err = d.SetNew("record", normalized[0])
+			} else {
+				err = d.SetNew("records", normalized)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// A CNAME record can not coexist with any other record of the same name.
+	// This is checked only when the name is set, since conflicting records
+	// can only be created by other resources afterwards.
+	if (d.Id() != "" && !d.HasChange("name")) || !d.NewValueKnown("name") ||
+		!d.NewValueKnown("zone_id") || zoneName == "" {
+		return nil
+	}
+
+	if dnsClient == nil {
+		var err error
+		dnsClient, err = config.dnsV2Client(config.Region)
+		if err != nil {
+			return fmt.Errorf("Error creating ECL DNS client: %s", err)
+		}
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	allRecordSets, err := dnsZoneRecordsV2List(dnsClient, zoneID)
+	if err != nil {
+		return err
+	}
+
+	var recordsetID string
+	if d.Id() != "" {
+		_, recordsetID, _ = parseDNSV2RecordSetID(d.Id())
+	}
+
+	name := dnsRecordSetV2Qualify(d.Get("name").(string), zoneName)
+	for _, rs := range allRecordSets {
+		if rs.ID == recordsetID || !strings.EqualFold(dnsRecordSetV2Qualify(rs.Name, ""), name) {
+			continue
+		}
+
+		if rrtype == "CNAME" || strings.ToUpper(rs.Type) == "CNAME" {
+			return fmt.Errorf("A CNAME record can not coexist with other records of the same name: "+
+				"%s %s record set %s already exists", rs.Name, rs.Type, rs.ID)
+		}
+	}
+
+	return nil
+}
+
+// dnsRecordSetV2Records returns the records of a record set.
+// ECL2.0 DNS API returns records either as a list or as a simple string.
+func dnsRecordSetV2Records(rs *recordsets.RecordSet) []string {
+	switch v := rs.Records.(type) {
+	case []interface{}:
+		records := make([]string, 0, len(v))
+		for _, r := range v {
+			if record, ok := r.(string); ok {
+				records = append(records, record)
+			}
+		}
+		return records
+	case string:
+		return []string{v}
+	}
+
+	return nil
+}
+
+// dnsRecordSetV2RecordHash hashes a record ignoring IPv6 [bracket] notation.
+func dnsRecordSetV2RecordHash(v interface{}) int {
+	return hashcode.String(formatDNSV2Records([]interface{}{v})[0])
+}
+
 func resourceDNSRecordSetV2Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
@@ -286,6 +581,13 @@ func formatDNSV2Records(recordsraw []interface{}) []string {
 	return records
 }
 
+// suppressDNSRecordSetV2NameDiffs suppresses diffs between a name relative
+// to the zone and its fully qualified form, and diffs in the case of a name.
+func suppressDNSRecordSetV2NameDiffs(k, old, new string, d *schema.ResourceData) bool {
+	zoneName := d.Get("zone_name").(string)
+	return strings.EqualFold(dnsRecordSetV2Qualify(old, zoneName), dnsRecordSetV2Qualify(new, zoneName))
+}
+
 // suppressRecordsDiffs will suppress diffs when the format of a record
 // is different yet still a valid DNS record. For example, if a user
 // specifies an IPv6 address using [bracket] notation, but the record is
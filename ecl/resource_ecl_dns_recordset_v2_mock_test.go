@@ -0,0 +1,453 @@
+package ecl
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/recordsets"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedDNSV2RecordSet_records(t *testing.T) {
+	var recordset recordsets.RecordSet
+
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "zone", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff", testMockDNSV2ZoneRecordsGetZone)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2RecordSetListZone)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2RecordSetCreate)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01", testMockDNSV2RecordSetGetAfterCreate)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01", testMockDNSV2RecordSetUpdate)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01", testMockDNSV2RecordSetGetAfterUpdate)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01", testMockDNSV2RecordSetDelete)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01", testMockDNSV2RecordSetGetAfterDelete)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockDNSV2RecordSetRecords,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists("ecl_dns_recordset_v2.recordset_1", &recordset),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_recordset_v2.recordset_1", "records.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testMockDNSV2RecordSetRecordsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists("ecl_dns_recordset_v2.recordset_1", &recordset),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_recordset_v2.recordset_1", "records.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+const testMockDNSV2RecordSetRecords = `
+resource "ecl_dns_recordset_v2" "recordset_1" {
+  zone_id = "cebb1607-40c2-466b-b76b-9fcc7a356bff"
+  name = "www.example.com."
+  type = "A"
+  ttl = 3000
+  records = ["10.1.0.2", "10.1.0.1"]
+}
+`
+
+const testMockDNSV2RecordSetRecordsUpdate = `
+resource "ecl_dns_recordset_v2" "recordset_1" {
+  zone_id = "cebb1607-40c2-466b-b76b-9fcc7a356bff"
+  name = "www.example.com."
+  type = "A"
+  ttl = 3000
+  records = ["10.1.0.1", "10.1.0.2", "10.1.0.3"]
+}
+`
+
+var testMockDNSV2RecordSetListZone = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "recordsets": [
+                {
+                    "id": "3b0f5e6a-2a86-4bd4-a4e4-8f6b3c0b1e01",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "example.com.",
+                    "type": "SOA",
+                    "ttl": 3600,
+                    "records": ["ns1.example.com. admin.example.com. 1 3600 600 86400 3600"],
+                    "description": ""
+                },
+                {
+                    "id": "3b0f5e6a-2a86-4bd4-a4e4-8f6b3c0b1e02",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "example.com.",
+                    "type": "NS",
+                    "ttl": 3600,
+                    "records": ["ns1.example.com."],
+                    "description": ""
+                },
+                {
+                    "id": "5d2c8f1e-7b3a-4c6d-9e0f-1a2b3c4d5e6f",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "web.example.com.",
+                    "type": "A",
+                    "ttl": 3600,
+                    "records": ["10.1.0.10"],
+                    "description": ""
+                }
+            ],
+            "links": {}
+        }
+`
+
+var testMockDNSV2RecordSetCreate = `
+request:
+    method: POST
+response:
+    code: 201
+    body: >
+        {
+            "recordsets": [
+                {
+                    "id": "a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "www.example.com.",
+                    "type": "A",
+                    "ttl": 3000,
+                    "records": ["10.1.0.2", "10.1.0.1"],
+                    "description": "",
+                    "created_at": "2019-01-01T00:00:00.000000",
+                    "updated_at": null
+                }
+            ]
+        }
+newStatus: Created
+`
+
+var testMockDNSV2RecordSetGetAfterCreate = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01",
+            "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+            "name": "www.example.com.",
+            "type": "A",
+            "ttl": 3000,
+            "records": ["10.1.0.1", "10.1.0.2"],
+            "description": "",
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null
+        }
+expectedStatus:
+    - Created
+`
+
+var testMockDNSV2RecordSetUpdate = `
+request:
+    method: PUT
+response:
+    code: 200
+    body: >
+        {
+            "id": "a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01",
+            "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+            "name": "www.example.com.",
+            "type": "A",
+            "ttl": 3000,
+            "records": ["10.1.0.1", "10.1.0.2", "10.1.0.3"],
+            "description": "",
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": "2019-01-01T00:01:00.000000"
+        }
+newStatus: Updated
+`
+
+var testMockDNSV2RecordSetGetAfterUpdate = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01",
+            "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+            "name": "www.example.com.",
+            "type": "A",
+            "ttl": 3000,
+            "records": ["10.1.0.3", "10.1.0.1", "10.1.0.2"],
+            "description": "",
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": "2019-01-01T00:01:00.000000"
+        }
+expectedStatus:
+    - Updated
+`
+
+var testMockDNSV2RecordSetDelete = `
+request:
+    method: DELETE
+response:
+    code: 204
+newStatus: Deleted
+`
+
+var testMockDNSV2RecordSetGetAfterDelete = `
+request:
+    method: GET
+response:
+    code: 404
+expectedStatus:
+    - Deleted
+`
+
+func TestMockedDNSV2RecordSet_normalize(t *testing.T) {
+	var recordset recordsets.RecordSet
+
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "zone", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff", testMockDNSV2ZoneRecordsGetZone)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2RecordSetListZone)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2RecordSetCreateMX)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/b7e1c2d3-4f5a-4b6c-8d7e-9f0a1b2c3d4e", testMockDNSV2RecordSetGetMX)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/b7e1c2d3-4f5a-4b6c-8d7e-9f0a1b2c3d4e", testMockDNSV2RecordSetDelete)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/b7e1c2d3-4f5a-4b6c-8d7e-9f0a1b2c3d4e", testMockDNSV2RecordSetGetAfterDelete)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockDNSV2RecordSetRelative,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists("ecl_dns_recordset_v2.recordset_1", &recordset),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_recordset_v2.recordset_1", "zone_name", "example.com."),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_recordset_v2.recordset_1", "name", "mail.example.com."),
+				),
+			},
+		},
+	})
+}
+
+func TestMockedDNSV2RecordSet_normalizeNewZone(t *testing.T) {
+	var recordset recordsets.RecordSet
+
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "zone", "/v2/zones", testMockDNSV2RecordSetCreateZone)
+	mc.Register(t, "zone", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff", testMockDNSV2RecordSetDeleteZone)
+	mc.Register(t, "zone", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff", testMockDNSV2RecordSetGetZoneAfterDelete)
+	mc.Register(t, "zone", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff", testMockDNSV2ZoneRecordsGetZone)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2RecordSetListZone)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2RecordSetCreateMX)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/b7e1c2d3-4f5a-4b6c-8d7e-9f0a1b2c3d4e", testMockDNSV2RecordSetGetMX)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/b7e1c2d3-4f5a-4b6c-8d7e-9f0a1b2c3d4e", testMockDNSV2RecordSetDelete)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/b7e1c2d3-4f5a-4b6c-8d7e-9f0a1b2c3d4e", testMockDNSV2RecordSetGetAfterDelete)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockDNSV2RecordSetRelativeNewZone,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists("ecl_dns_recordset_v2.recordset_1", &recordset),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_recordset_v2.recordset_1", "zone_name", "example.com."),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_recordset_v2.recordset_1", "name", "mail.example.com."),
+				),
+			},
+		},
+	})
+}
+
+func TestMockedDNSV2RecordSet_cnameConflict(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "zone", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff", testMockDNSV2ZoneRecordsGetZone)
+	mc.Register(t, "recordset", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2RecordSetListZone)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testMockDNSV2RecordSetCNAMEConflict,
+				ExpectError: regexp.MustCompile("A CNAME record can not coexist with other records of the same name"),
+			},
+			resource.TestStep{
+				Config:      testMockDNSV2RecordSetInvalidRecord,
+				ExpectError: regexp.MustCompile(`records: "10.1.0" is not a valid IPv4 address`),
+			},
+		},
+	})
+}
+
+const testMockDNSV2RecordSetRelative = `
+resource "ecl_dns_recordset_v2" "recordset_1" {
+  zone_id = "cebb1607-40c2-466b-b76b-9fcc7a356bff"
+  name = "Mail"
+  type = "MX"
+  ttl = 3000
+  records = ["10 mx1", "20 mx2.example.com"]
+}
+`
+
+const testMockDNSV2RecordSetCNAMEConflict = `
+resource "ecl_dns_recordset_v2" "recordset_1" {
+  zone_id = "cebb1607-40c2-466b-b76b-9fcc7a356bff"
+  name = "web"
+  type = "CNAME"
+  ttl = 3000
+  records = ["www"]
+}
+`
+
+const testMockDNSV2RecordSetInvalidRecord = `
+resource "ecl_dns_recordset_v2" "recordset_1" {
+  zone_id = "cebb1607-40c2-466b-b76b-9fcc7a356bff"
+  name = "www"
+  type = "A"
+  ttl = 3000
+  records = ["10.1.0"]
+}
+`
+
+var testMockDNSV2RecordSetCreateMX = `
+request:
+    method: POST
+    body: >
+        {"name":"Mail.example.com.","records":["20 mx2.example.com.","10 mx1.example.com."],"ttl":3000,"type":"MX"}
+response:
+    code: 201
+    body: >
+        {
+            "recordsets": [
+                {
+                    "id": "b7e1c2d3-4f5a-4b6c-8d7e-9f0a1b2c3d4e",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "mail.example.com.",
+                    "type": "MX",
+                    "ttl": 3000,
+                    "records": ["10 mx1.example.com.", "20 mx2.example.com."],
+                    "description": ""
+                }
+            ]
+        }
+newStatus: Created
+`
+
+var testMockDNSV2RecordSetGetMX = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "b7e1c2d3-4f5a-4b6c-8d7e-9f0a1b2c3d4e",
+            "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+            "name": "mail.example.com.",
+            "type": "MX",
+            "ttl": 3000,
+            "records": ["20 mx2.example.com.", "10 mx1.example.com."],
+            "description": ""
+        }
+expectedStatus:
+    - Created
+`
+
+const testMockDNSV2RecordSetRelativeNewZone = `
+resource "ecl_dns_zone_v2" "zone_1" {
+  name = "example.com."
+}
+
+resource "ecl_dns_recordset_v2" "recordset_1" {
+  zone_id = "${ecl_dns_zone_v2.zone_1.id}"
+  name = "Mail"
+  type = "MX"
+  ttl = 3000
+  records = ["10 mx1", "20 mx2.example.com"]
+}
+`
+
+var testMockDNSV2RecordSetCreateZone = `
+request:
+    method: POST
+response:
+    code: 202
+    body: >
+        {
+            "id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "example.com.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 1,
+            "status": "CREATING",
+            "action": "CREATE",
+            "description": "",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+`
+
+var testMockDNSV2RecordSetDeleteZone = `
+request:
+    method: DELETE
+response:
+    code: 202
+newStatus: Deleted
+`
+
+var testMockDNSV2RecordSetGetZoneAfterDelete = `
+request:
+    method: GET
+response:
+    code: 404
+expectedStatus:
+    - Deleted
+`
@@ -129,6 +129,59 @@ func TestAccDNSV2RecordSet_ipv6(t *testing.T) {
 	})
 }
 
+func TestAccDNSV2RecordSet_records(t *testing.T) {
+	var recordset recordsets.RecordSet
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2RecordSetRecords(zoneName, `"10.1.0.2", "10.1.0.1"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists("ecl_dns_recordset_v2.recordset_1", &recordset),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_recordset_v2.recordset_1", "records.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDNSV2RecordSetRecords(zoneName, `"10.1.0.1", "10.1.0.2", "10.1.0.3"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists("ecl_dns_recordset_v2.recordset_1", &recordset),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_recordset_v2.recordset_1", "records.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestDNSV2RecordSetStateUpgradeV0(t *testing.T) {
+	v0 := map[string]interface{}{
+		"zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+		"name":    "www.example.com.",
+		"type":    "A",
+		"ttl":     3000,
+		"record":  "10.1.0.1",
+	}
+
+	v1, err := resourceDNSRecordSetV2StateUpgradeV0(v0, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	records, ok := v1["records"].([]interface{})
+	if !ok || len(records) != 1 || records[0] != "10.1.0.1" {
+		t.Fatalf("Unexpected records: %#v", v1["records"])
+	}
+
+	if v1["record"] != "10.1.0.1" {
+		t.Fatalf("Unexpected record: %#v", v1["record"])
+	}
+}
+
 func testAccCheckDNSV2RecordSetDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
@@ -308,6 +361,27 @@ func testAccDNSV2RecordSetIPv6(zoneName string) string {
 	`, zoneName, zoneName)
 }
 
+func testAccDNSV2RecordSetRecords(zoneName, records string) string {
+	return fmt.Sprintf(`
+		resource "ecl_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email2@example.com"
+			description = "a zone"
+			ttl = 6000
+			type = "PRIMARY"
+		}
+
+		resource "ecl_dns_recordset_v2" "recordset_1" {
+			zone_id = "${ecl_dns_zone_v2.zone_1.id}"
+			type = "A"
+			name = "%s"
+			description = "a round-robin record set"
+			ttl = 3000
+			records = [%s]
+		}
+	`, zoneName, zoneName, records)
+}
+
 func maxLengthDomainNameFromZoneName(zoneName string) string {
 	return strings.Repeat("a", 63) + "." + zoneName
 }
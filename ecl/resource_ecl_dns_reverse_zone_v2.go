@@ -0,0 +1,373 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/zones"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDNSReverseZoneV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSReverseZoneV2Create,
+		Read:   resourceDNSReverseZoneV2Read,
+		Update: resourceDNSReverseZoneV2Update,
+		Delete: resourceDNSReverseZoneV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDNSReverseZoneV2Import,
+		},
+
+		CustomizeDiff: resourceDNSReverseZoneV2CustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"submask_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone_names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"zones": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDNSReverseZoneV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	cidr := d.Get("cidr").(string)
+	names, err := dnsReverseZoneV2Names(cidr, d.Get("submask_length").(int))
+	if err != nil {
+		return err
+	}
+
+	id := cidr
+	if !strings.Contains(cidr, "/") {
+		id = fmt.Sprintf("%s/%d", cidr, d.Get("submask_length").(int))
+	}
+
+	d.SetId(id)
+	if err := resourceDNSReverseZoneV2CreateZones(d, dnsClient, names, d.Timeout(schema.TimeoutCreate)); err != nil {
+		// Keep the resource only when some zones have been created,
+		// so that they are deleted.
+		if len(d.Get("zones").([]interface{})) == 0 {
+			d.SetId("")
+		}
+		return err
+	}
+
+	log.Printf("[DEBUG] Created ECL DNS reverse zones for %s: %#v", id, names)
+	return resourceDNSReverseZoneV2Read(d, meta)
+}
+
+func resourceDNSReverseZoneV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	names, err := dnsReverseZoneV2Names(d.Get("cidr").(string), d.Get("submask_length").(int))
+	if err != nil {
+		return err
+	}
+
+	allZones, err := dnsReverseZoneV2ListZones(dnsClient)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]zones.Zone, len(allZones))
+	for _, zone := range allZones {
+		byName[strings.ToLower(zone.Name)] = zone
+	}
+
+	// zone_names only has the zones found, so that the missing ones are
+	// planned to be created again.
+	var found []map[string]interface{}
+	var foundNames []string
+	var description string
+	for _, name := range names {
+		zone, ok := byName[name]
+		if !ok {
+			log.Printf("[DEBUG] ECL DNS reverse zone %s is not found", name)
+			continue
+		}
+		found = append(found, map[string]interface{}{
+			"id":   zone.ID,
+			"name": zone.Name,
+		})
+		foundNames = append(foundNames, name)
+		description = zone.Description
+	}
+
+	if len(found) == 0 {
+		log.Printf("[DEBUG] ECL DNS reverse zones of %s are not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Retrieved ECL DNS reverse zones of %s: %#v", d.Id(), found)
+
+	d.Set("description", description)
+	d.Set("zone_names", foundNames)
+	if err := d.Set("zones", found); err != nil {
+		return fmt.Errorf("Unable to set zones: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDNSReverseZoneV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("zone_names") {
+		existing := make(map[string]bool)
+		for _, raw := range d.Get("zones").([]interface{}) {
+			existing[strings.ToLower(raw.(map[string]interface{})["name"].(string))] = true
+		}
+
+		var missing []string
+		for _, name := range d.Get("zone_names").([]interface{}) {
+			if !existing[name.(string)] {
+				missing = append(missing, name.(string))
+			}
+		}
+
+		log.Printf("[DEBUG] Creating missing ECL DNS reverse zones of %s: %#v", d.Id(), missing)
+		err := resourceDNSReverseZoneV2CreateZones(d, dnsClient, missing, d.Timeout(schema.TimeoutUpdate))
+		d.SetPartial("zones")
+		if err != nil {
+			return err
+		}
+		d.SetPartial("zone_names")
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts := zones.UpdateOpts{
+			Description: &description,
+		}
+
+		for _, zoneID := range resourceDNSReverseZoneV2ZoneIDs(d) {
+			log.Printf("[DEBUG] Updating Zone %s with options: %#v", zoneID, updateOpts)
+
+			_, err = zones.Update(dnsClient, zoneID, updateOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error updating ECL DNS Zone: %s", err)
+			}
+
+			log.Printf("[DEBUG] Waiting for DNS Zone (%s) to update", zoneID)
+			stateConf := &resource.StateChangeConf{
+				Target:     []string{"ACTIVE"},
+				Pending:    []string{"PENDING"},
+				Refresh:    waitForDNSZone(dnsClient, zoneID),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				Delay:      5 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, err = stateConf.WaitForState()
+			if err != nil {
+				return fmt.Errorf(
+					"Error waiting for zone (%s) to become active: %s",
+					zoneID, err)
+			}
+		}
+		d.SetPartial("description")
+	}
+
+	d.Partial(false)
+
+	return resourceDNSReverseZoneV2Read(d, meta)
+}
+
+func resourceDNSReverseZoneV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	for _, zoneID := range resourceDNSReverseZoneV2ZoneIDs(d) {
+		_, err = zones.Delete(dnsClient, zoneID).Extract()
+		if err != nil {
+			if _, ok := err.(eclcloud.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("Error deleting ECL DNS Zone: %s", err)
+		}
+
+		log.Printf("[DEBUG] Waiting for DNS Zone (%s) to be deleted", zoneID)
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"DELETED"},
+			Pending:    []string{"ACTIVE", "PENDING"},
+			Refresh:    waitForDNSZone(dnsClient, zoneID),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for zone (%s) to delete: %s",
+				zoneID, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceDNSReverseZoneV2Import sets cidr and submask_length from an ID
+// in CIDR notation.
+func resourceDNSReverseZoneV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid format specified for ECL DNS reverse zone. Format must be <cidr>/<submask_length>")
+	}
+
+	submaskLength, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid submask_length %q: %s", parts[1], err)
+	}
+
+	d.Set("cidr", parts[0])
+	d.Set("submask_length", submaskLength)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceDNSReverseZoneV2CustomizeDiff derives the zone names at plan time,
+// so that they can be referred to by other resources.
+func resourceDNSReverseZoneV2CustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("cidr") || !d.NewValueKnown("submask_length") {
+		return nil
+	}
+
+	names, err := dnsReverseZoneV2Names(d.Get("cidr").(string), d.Get("submask_length").(int))
+	if err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return d.SetNew("zone_names", names)
+	}
+
+	// Zones deleted outside of Terraform are missing from zone_names.
+	// They are created again by Update.
+	current := d.Get("zone_names").([]interface{})
+	if !d.HasChange("cidr") && !d.HasChange("submask_length") && len(current) == len(names) {
+		return nil
+	}
+
+	if err := d.SetNew("zone_names", names); err != nil {
+		return err
+	}
+	return d.SetNewComputed("zones")
+}
+
+// resourceDNSReverseZoneV2CreateZones creates the zones of names. They are
+// added to zones one by one, so that they are deleted even if creating
+// the others fails.
+func resourceDNSReverseZoneV2CreateZones(d *schema.ResourceData, client *eclcloud.ServiceClient, names []string, timeout time.Duration) error {
+	created := d.Get("zones").([]interface{})
+	for _, name := range names {
+		createOpts := ZoneCreateOpts{
+			zones.CreateOpts{
+				Name:        name,
+				Description: d.Get("description").(string),
+			},
+		}
+
+		log.Printf("[DEBUG] Create Options: %#v", createOpts)
+		n, err := zones.Create(client, createOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error creating ECL DNS reverse zone %s: %s", name, err)
+		}
+
+		created = append(created, map[string]interface{}{
+			"id":   n.ID,
+			"name": n.Name,
+		})
+		d.Set("zones", created)
+
+		log.Printf("[DEBUG] Waiting for DNS Zone (%s) to become available", n.ID)
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"ACTIVE"},
+			Pending:    []string{"PENDING", "CREATING"},
+			Refresh:    waitForDNSZone(client, n.ID),
+			Timeout:    timeout,
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for zone (%s) to become active: %s",
+				n.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDNSReverseZoneV2ZoneIDs(d *schema.ResourceData) []string {
+	var ids []string
+	for _, raw := range d.Get("zones").([]interface{}) {
+		ids = append(ids, raw.(map[string]interface{})["id"].(string))
+	}
+	return ids
+}
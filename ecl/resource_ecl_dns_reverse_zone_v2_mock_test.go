@@ -0,0 +1,889 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/zones"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedDNSV2ReverseZone_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "zone", "/v2/zones", testMockDNSV2ReverseZoneCreate)
+	mc.Register(t, "zone", "/v2/zones", testMockDNSV2ReverseZoneListAfterCreate)
+	mc.Register(t, "zone", "/v2/zones", testMockDNSV2ReverseZoneListAfterUpdate)
+	mc.Register(t, "zone", "/v2/zones", testMockDNSV2ReverseZoneListAfterDelete)
+	mc.Register(t, "zone", "/v2/zones/5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13", testMockDNSV2ReverseZoneGetAfterCreate)
+	mc.Register(t, "zone", "/v2/zones/5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13", testMockDNSV2ReverseZoneUpdate)
+	mc.Register(t, "zone", "/v2/zones/5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13", testMockDNSV2ReverseZoneGetAfterUpdate)
+	mc.Register(t, "zone", "/v2/zones/5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13", testMockDNSV2ReverseZoneDelete)
+	mc.Register(t, "zone", "/v2/zones/5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13", testMockDNSV2ReverseZoneGetAfterDelete)
+	mc.Register(t, "ptr", "/v2/zones/5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13/recordsets", testMockDNSV2PTRRecordCreate)
+	mc.Register(t, "ptr", "/v2/zones/5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13/recordsets/8e7c6b5a-4d3c-4b2a-9e1f-0a9b8c7d6e5f", testMockDNSV2PTRRecordGetAfterCreate)
+	mc.Register(t, "ptr", "/v2/zones/5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13/recordsets/8e7c6b5a-4d3c-4b2a-9e1f-0a9b8c7d6e5f", testMockDNSV2PTRRecordUpdate)
+	mc.Register(t, "ptr", "/v2/zones/5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13/recordsets/8e7c6b5a-4d3c-4b2a-9e1f-0a9b8c7d6e5f", testMockDNSV2PTRRecordGetAfterUpdate)
+	mc.Register(t, "ptr", "/v2/zones/5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13/recordsets/8e7c6b5a-4d3c-4b2a-9e1f-0a9b8c7d6e5f", testMockDNSV2PTRRecordDelete)
+	mc.Register(t, "ptr", "/v2/zones/5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13/recordsets/8e7c6b5a-4d3c-4b2a-9e1f-0a9b8c7d6e5f", testMockDNSV2PTRRecordGetAfterDelete)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDNSV2PTRRecordDestroy,
+			testAccCheckDNSV2ReverseZoneDestroy,
+		),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockDNSV2ReverseZoneBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ReverseZoneExists("ecl_dns_reverse_zone_v2.reverse_zone_1"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "id", "203.0.113.16/28"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "zone_names.0", "16-28.113.0.203.in-addr.arpa."),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "zones.0.id", "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_ptr_record_v2.ptr_1", "name", "17.16-28.113.0.203.in-addr.arpa."),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_ptr_record_v2.ptr_1", "ptrdname", "mail.example.com."),
+				),
+			},
+			resource.TestStep{
+				Config: testMockDNSV2ReverseZoneBasicUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "description", "an updated reverse zone"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_ptr_record_v2.ptr_1", "ptrdname", "smtp.example.com."),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_ptr_record_v2.ptr_1", "ttl", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestMockedDNSV2ReverseZone_missingZone(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "zone_100", "/v2/zones", testMockDNSV2ReverseZoneCreate100)
+	mc.Register(t, "zone_101", "/v2/zones", testMockDNSV2ReverseZoneCreate101)
+	mc.Register(t, "zone_101", "/v2/zones", testMockDNSV2ReverseZoneRecreate101)
+	mc.Register(t, "zone_101", "/v2/zones", testMockDNSV2ReverseZoneListBoth)
+	mc.Register(t, "zone_101", "/v2/zones", testMockDNSV2ReverseZoneListMissing)
+	mc.Register(t, "zone_101", "/v2/zones", testMockDNSV2ReverseZoneListRecreated)
+	mc.Register(t, "zone_101", "/v2/zones", testMockDNSV2ReverseZoneListAfterDelete)
+	mc.Register(t, "zone_100", "/v2/zones/3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b100", testMockDNSV2ReverseZoneGet100)
+	mc.Register(t, "zone_100", "/v2/zones/3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b100", testMockDNSV2ReverseZoneDelete100)
+	mc.Register(t, "zone_100", "/v2/zones/3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b100", testMockDNSV2ReverseZoneGetAfterDelete)
+	mc.Register(t, "zone_101", "/v2/zones/3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b101", testMockDNSV2ReverseZoneGet101)
+	mc.Register(t, "zone_101", "/v2/zones/3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b101", testMockDNSV2ReverseZoneDelete101)
+	mc.Register(t, "zone_101", "/v2/zones/3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b101", testMockDNSV2ReverseZoneGetMissing101)
+	mc.Register(t, "zone_101", "/v2/zones/3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b102", testMockDNSV2ReverseZoneGetRecreated101)
+	mc.Register(t, "zone_101", "/v2/zones/3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b102", testMockDNSV2ReverseZoneDeleteRecreated101)
+	mc.Register(t, "zone_101", "/v2/zones/3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b102", testMockDNSV2ReverseZoneGetAfterDelete)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2ReverseZoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockDNSV2ReverseZoneBlock,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ReverseZoneExists("ecl_dns_reverse_zone_v2.reverse_zone_1"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "zones.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "zones.1.id", "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b101"),
+				),
+			},
+			resource.TestStep{
+				// One of the zones is deleted outside of Terraform.
+				PreConfig: func() {
+					config := testAccProvider.Meta().(*Config)
+					dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+					if err != nil {
+						t.Fatalf("Error creating ECL DNS client: %s", err)
+					}
+					if err := zones.Delete(dnsClient, "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b101").Err; err != nil {
+						t.Fatalf("Error deleting ECL DNS zone: %s", err)
+					}
+				},
+				Config: testMockDNSV2ReverseZoneBlock,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ReverseZoneExists("ecl_dns_reverse_zone_v2.reverse_zone_1"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "zone_names.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "zones.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "zones.1.id", "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b102"),
+				),
+			},
+		},
+	})
+}
+
+const testMockDNSV2ReverseZoneBasic = `
+resource "ecl_dns_reverse_zone_v2" "reverse_zone_1" {
+  cidr = "203.0.113.16"
+  submask_length = 28
+  description = "a reverse zone"
+}
+
+resource "ecl_dns_ptr_record_v2" "ptr_1" {
+  zone_id = "${ecl_dns_reverse_zone_v2.reverse_zone_1.zones.0.id}"
+  ip_address = "203.0.113.17"
+  ptrdname = "mail.example.com"
+}
+`
+
+const testMockDNSV2ReverseZoneBasicUpdate = `
+resource "ecl_dns_reverse_zone_v2" "reverse_zone_1" {
+  cidr = "203.0.113.16"
+  submask_length = 28
+  description = "an updated reverse zone"
+}
+
+resource "ecl_dns_ptr_record_v2" "ptr_1" {
+  zone_id = "${ecl_dns_reverse_zone_v2.reverse_zone_1.zones.0.id}"
+  ip_address = "203.0.113.17"
+  ptrdname = "smtp.example.com."
+  ttl = 600
+}
+`
+
+var testMockDNSV2ReverseZoneCreate = `
+request:
+    method: POST
+    body: >
+        {"description":"a reverse zone","name":"16-28.113.0.203.in-addr.arpa."}
+response:
+    code: 202
+    body: >
+        {
+            "id": "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "16-28.113.0.203.in-addr.arpa.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 1,
+            "status": "PENDING",
+            "action": "",
+            "description": "a reverse zone",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+newStatus: Created
+`
+
+var testMockDNSV2ReverseZoneGetAfterCreate = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "16-28.113.0.203.in-addr.arpa.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 1,
+            "status": "ACTIVE",
+            "action": "",
+            "description": "a reverse zone",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+expectedStatus:
+    - Created
+`
+
+var testMockDNSV2ReverseZoneListAfterCreate = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "zones": [
+                {
+                    "id": "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13",
+                    "pool_id": "",
+                    "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+                    "name": "16-28.113.0.203.in-addr.arpa.",
+                    "email": "",
+                    "ttl": 3600,
+                    "serial": 1,
+                    "status": "ACTIVE",
+                    "action": "",
+                    "description": "a reverse zone",
+                    "masters": [],
+                    "type": "",
+                    "transferred_at": null,
+                    "version": 1,
+                    "created_at": "2019-01-01T00:00:00.000000",
+                    "updated_at": null,
+                    "links": {}
+                }
+            ],
+            "links": {}
+        }
+expectedStatus:
+    - Created
+`
+
+var testMockDNSV2ReverseZoneUpdate = `
+request:
+    method: PATCH
+    body: >
+        {"description":"an updated reverse zone"}
+response:
+    code: 202
+    body: >
+        {
+            "id": "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "16-28.113.0.203.in-addr.arpa.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 2,
+            "status": "PENDING",
+            "action": "",
+            "description": "an updated reverse zone",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+expectedStatus:
+    - Created
+newStatus: Updated
+`
+
+var testMockDNSV2ReverseZoneGetAfterUpdate = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "16-28.113.0.203.in-addr.arpa.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 2,
+            "status": "ACTIVE",
+            "action": "",
+            "description": "an updated reverse zone",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+expectedStatus:
+    - Updated
+`
+
+var testMockDNSV2ReverseZoneListAfterUpdate = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "zones": [
+                {
+                    "id": "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13",
+                    "pool_id": "",
+                    "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+                    "name": "16-28.113.0.203.in-addr.arpa.",
+                    "email": "",
+                    "ttl": 3600,
+                    "serial": 2,
+                    "status": "ACTIVE",
+                    "action": "",
+                    "description": "an updated reverse zone",
+                    "masters": [],
+                    "type": "",
+                    "transferred_at": null,
+                    "version": 1,
+                    "created_at": "2019-01-01T00:00:00.000000",
+                    "updated_at": null,
+                    "links": {}
+                }
+            ],
+            "links": {}
+        }
+expectedStatus:
+    - Updated
+`
+
+var testMockDNSV2ReverseZoneDelete = `
+request:
+    method: DELETE
+response:
+    code: 202
+    body: >
+        {
+            "id": "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "16-28.113.0.203.in-addr.arpa.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 2,
+            "status": "PENDING",
+            "action": "",
+            "description": "an updated reverse zone",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+expectedStatus:
+    - Updated
+newStatus: Deleted
+`
+
+var testMockDNSV2ReverseZoneGetAfterDelete = `
+request:
+    method: GET
+response:
+    code: 404
+expectedStatus:
+    - Deleted
+`
+
+var testMockDNSV2ReverseZoneListAfterDelete = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "zones": [],
+            "links": {}
+        }
+expectedStatus:
+    - Deleted
+`
+
+var testMockDNSV2PTRRecordCreate = `
+request:
+    method: POST
+    body: >
+        {"name":"17.16-28.113.0.203.in-addr.arpa.","records":["mail.example.com."],"ttl":3600,"type":"PTR"}
+response:
+    code: 201
+    body: >
+        {
+            "recordsets": [
+                {
+                    "id": "8e7c6b5a-4d3c-4b2a-9e1f-0a9b8c7d6e5f",
+                    "zone_id": "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13",
+                    "name": "17.16-28.113.0.203.in-addr.arpa.",
+                    "type": "PTR",
+                    "ttl": 3600,
+                    "records": ["mail.example.com."],
+                    "description": "",
+                    "created_at": "2019-01-01T00:00:00.000000",
+                    "updated_at": null
+                }
+            ]
+        }
+newStatus: Created
+`
+
+var testMockDNSV2PTRRecordGetAfterCreate = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "8e7c6b5a-4d3c-4b2a-9e1f-0a9b8c7d6e5f",
+            "zone_id": "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13",
+            "name": "17.16-28.113.0.203.in-addr.arpa.",
+            "type": "PTR",
+            "ttl": 3600,
+            "records": ["mail.example.com."],
+            "description": "",
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null
+        }
+expectedStatus:
+    - Created
+`
+
+var testMockDNSV2PTRRecordUpdate = `
+request:
+    method: PUT
+    body: >
+        {"description":"","name":"17.16-28.113.0.203.in-addr.arpa.","records":["smtp.example.com."],"ttl":600}
+response:
+    code: 200
+    body: >
+        {
+            "id": "8e7c6b5a-4d3c-4b2a-9e1f-0a9b8c7d6e5f",
+            "zone_id": "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13",
+            "name": "17.16-28.113.0.203.in-addr.arpa.",
+            "type": "PTR",
+            "ttl": 600,
+            "records": ["smtp.example.com."],
+            "description": "",
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null
+        }
+newStatus: Updated
+`
+
+var testMockDNSV2PTRRecordGetAfterUpdate = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "8e7c6b5a-4d3c-4b2a-9e1f-0a9b8c7d6e5f",
+            "zone_id": "5d0a3a6c-7e4b-4c1e-9f2d-2b8f1c0e7a13",
+            "name": "17.16-28.113.0.203.in-addr.arpa.",
+            "type": "PTR",
+            "ttl": 600,
+            "records": ["smtp.example.com."],
+            "description": "",
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null
+        }
+expectedStatus:
+    - Updated
+`
+
+var testMockDNSV2PTRRecordDelete = `
+request:
+    method: DELETE
+response:
+    code: 204
+newStatus: Deleted
+`
+
+var testMockDNSV2PTRRecordGetAfterDelete = `
+request:
+    method: GET
+response:
+    code: 404
+expectedStatus:
+    - Deleted
+`
+
+const testMockDNSV2ReverseZoneBlock = `
+resource "ecl_dns_reverse_zone_v2" "reverse_zone_1" {
+  cidr = "198.51.100.0/23"
+  submask_length = 23
+  description = "reverse zones"
+}
+`
+
+var testMockDNSV2ReverseZoneCreate100 = `
+request:
+    method: POST
+    body: >
+        {"description":"reverse zones","name":"100.51.198.in-addr.arpa."}
+response:
+    code: 202
+    body: >
+        {
+            "id": "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b100",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "100.51.198.in-addr.arpa.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 1,
+            "status": "PENDING",
+            "action": "",
+            "description": "reverse zones",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+newStatus: Created
+`
+
+var testMockDNSV2ReverseZoneCreate101 = `
+request:
+    method: POST
+    body: >
+        {"description":"reverse zones","name":"101.51.198.in-addr.arpa."}
+response:
+    code: 202
+    body: >
+        {
+            "id": "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b101",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "101.51.198.in-addr.arpa.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 1,
+            "status": "PENDING",
+            "action": "",
+            "description": "reverse zones",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+expectedStatus:
+    - 
+newStatus: Created
+`
+
+var testMockDNSV2ReverseZoneRecreate101 = `
+request:
+    method: POST
+    body: >
+        {"description":"reverse zones","name":"101.51.198.in-addr.arpa."}
+response:
+    code: 202
+    body: >
+        {
+            "id": "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b102",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "101.51.198.in-addr.arpa.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 1,
+            "status": "PENDING",
+            "action": "",
+            "description": "reverse zones",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+expectedStatus:
+    - Missing
+newStatus: Recreated
+`
+
+var testMockDNSV2ReverseZoneListBoth = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "zones": [
+                {
+                    "id": "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b100",
+                    "pool_id": "",
+                    "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+                    "name": "100.51.198.in-addr.arpa.",
+                    "email": "",
+                    "ttl": 3600,
+                    "serial": 1,
+                    "status": "ACTIVE",
+                    "action": "",
+                    "description": "reverse zones",
+                    "masters": [],
+                    "type": "",
+                    "transferred_at": null,
+                    "version": 1,
+                    "created_at": "2019-01-01T00:00:00.000000",
+                    "updated_at": null,
+                    "links": {}
+                },
+                {
+                    "id": "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b101",
+                    "pool_id": "",
+                    "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+                    "name": "101.51.198.in-addr.arpa.",
+                    "email": "",
+                    "ttl": 3600,
+                    "serial": 1,
+                    "status": "ACTIVE",
+                    "action": "",
+                    "description": "reverse zones",
+                    "masters": [],
+                    "type": "",
+                    "transferred_at": null,
+                    "version": 1,
+                    "created_at": "2019-01-01T00:00:00.000000",
+                    "updated_at": null,
+                    "links": {}
+                }
+            ],
+            "links": {}
+        }
+expectedStatus:
+    - Created
+`
+
+var testMockDNSV2ReverseZoneListMissing = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "zones": [
+                {
+                    "id": "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b100",
+                    "pool_id": "",
+                    "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+                    "name": "100.51.198.in-addr.arpa.",
+                    "email": "",
+                    "ttl": 3600,
+                    "serial": 1,
+                    "status": "ACTIVE",
+                    "action": "",
+                    "description": "reverse zones",
+                    "masters": [],
+                    "type": "",
+                    "transferred_at": null,
+                    "version": 1,
+                    "created_at": "2019-01-01T00:00:00.000000",
+                    "updated_at": null,
+                    "links": {}
+                }
+            ],
+            "links": {}
+        }
+expectedStatus:
+    - Missing
+`
+
+var testMockDNSV2ReverseZoneListRecreated = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "zones": [
+                {
+                    "id": "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b100",
+                    "pool_id": "",
+                    "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+                    "name": "100.51.198.in-addr.arpa.",
+                    "email": "",
+                    "ttl": 3600,
+                    "serial": 1,
+                    "status": "ACTIVE",
+                    "action": "",
+                    "description": "reverse zones",
+                    "masters": [],
+                    "type": "",
+                    "transferred_at": null,
+                    "version": 1,
+                    "created_at": "2019-01-01T00:00:00.000000",
+                    "updated_at": null,
+                    "links": {}
+                },
+                {
+                    "id": "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b102",
+                    "pool_id": "",
+                    "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+                    "name": "101.51.198.in-addr.arpa.",
+                    "email": "",
+                    "ttl": 3600,
+                    "serial": 1,
+                    "status": "ACTIVE",
+                    "action": "",
+                    "description": "reverse zones",
+                    "masters": [],
+                    "type": "",
+                    "transferred_at": null,
+                    "version": 1,
+                    "created_at": "2019-01-01T00:00:00.000000",
+                    "updated_at": null,
+                    "links": {}
+                }
+            ],
+            "links": {}
+        }
+expectedStatus:
+    - Recreated
+`
+
+var testMockDNSV2ReverseZoneGet100 = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b100",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "100.51.198.in-addr.arpa.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 1,
+            "status": "ACTIVE",
+            "action": "",
+            "description": "reverse zones",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+expectedStatus:
+    - Created
+`
+
+var testMockDNSV2ReverseZoneDelete100 = `
+request:
+    method: DELETE
+response:
+    code: 202
+expectedStatus:
+    - Created
+newStatus: Deleted
+`
+
+var testMockDNSV2ReverseZoneGet101 = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b101",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "101.51.198.in-addr.arpa.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 1,
+            "status": "ACTIVE",
+            "action": "",
+            "description": "reverse zones",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+expectedStatus:
+    - Created
+`
+
+var testMockDNSV2ReverseZoneDelete101 = `
+request:
+    method: DELETE
+response:
+    code: 202
+expectedStatus:
+    - Created
+newStatus: Missing
+`
+
+var testMockDNSV2ReverseZoneGetMissing101 = `
+request:
+    method: GET
+response:
+    code: 404
+expectedStatus:
+    - Missing
+    - Recreated
+    - Deleted
+`
+
+var testMockDNSV2ReverseZoneGetRecreated101 = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "3c1f0e2d-1a00-4b5c-8d6e-7f8091a2b102",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "101.51.198.in-addr.arpa.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 1,
+            "status": "ACTIVE",
+            "action": "",
+            "description": "reverse zones",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+expectedStatus:
+    - Recreated
+`
+
+var testMockDNSV2ReverseZoneDeleteRecreated101 = `
+request:
+    method: DELETE
+response:
+    code: 202
+expectedStatus:
+    - Recreated
+newStatus: Deleted
+`
@@ -0,0 +1,196 @@
+package ecl
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/recordsets"
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/zones"
+)
+
+func TestAccDNSV2ReverseZone_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckPublicIP(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDNSV2PTRRecordDestroy,
+			testAccCheckDNSV2ReverseZoneDestroy,
+		),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2ReverseZoneBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ReverseZoneExists("ecl_dns_reverse_zone_v2.reverse_zone_1"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "zones.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "description", "a reverse zone"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_ptr_record_v2.ptr_1", "ptrdname", "mail.example.com."),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDNSV2ReverseZoneUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ReverseZoneExists("ecl_dns_reverse_zone_v2.reverse_zone_1"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_reverse_zone_v2.reverse_zone_1", "description", "an updated reverse zone"),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_ptr_record_v2.ptr_1", "ptrdname", "smtp.example.com."),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_ptr_record_v2.ptr_1", "ttl", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2ReverseZoneDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ecl_dns_reverse_zone_v2" {
+			continue
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !testAccDNSV2ReverseZoneIDAttribute(k) {
+				continue
+			}
+			_, err := zones.Get(dnsClient, v).Extract()
+			if err == nil {
+				return fmt.Errorf("Reverse zone still exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDNSV2ReverseZoneExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating ECL DNS client: %s", err)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !testAccDNSV2ReverseZoneIDAttribute(k) {
+				continue
+			}
+			found, err := zones.Get(dnsClient, v).Extract()
+			if err != nil {
+				return err
+			}
+			if found.ID != v {
+				return fmt.Errorf("Reverse zone not found")
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccDNSV2ReverseZoneIDAttribute reports whether k is zones.N.id.
+func testAccDNSV2ReverseZoneIDAttribute(k string) bool {
+	return strings.HasPrefix(k, "zones.") && strings.HasSuffix(k, ".id")
+}
+
+func testAccCheckDNSV2PTRRecordDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ecl_dns_ptr_record_v2" {
+			continue
+		}
+
+		zoneID, recordsetID, err := parseDNSV2RecordSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = recordsets.Get(dnsClient, zoneID, recordsetID).Extract()
+		if err == nil {
+			return fmt.Errorf("PTR record still exists")
+		}
+	}
+
+	return nil
+}
+
+var testAccDNSV2ReverseZonePublicIP = fmt.Sprintf(`
+data "ecl_network_internet_service_v2" "internet_service_1" {
+  name = "Internet-Service-01"
+}
+
+resource "ecl_network_internet_gateway_v2" "internet_gateway_1" {
+  name = "Terraform_Test_Internet_Gateway_01"
+  internet_service_id = "${data.ecl_network_internet_service_v2.internet_service_1.id}"
+  qos_option_id = "%s"
+}
+
+resource "ecl_network_public_ip_v2" "public_ip_1" {
+  name = "Terraform_Test_Public_IP_01"
+  internet_gw_id = "${ecl_network_internet_gateway_v2.internet_gateway_1.id}"
+  submask_length = 28
+}
+`, OS_QOS_OPTION_ID_10M)
+
+var testAccDNSV2ReverseZoneBasic = fmt.Sprintf(`
+%s
+
+resource "ecl_dns_reverse_zone_v2" "reverse_zone_1" {
+  cidr = "${ecl_network_public_ip_v2.public_ip_1.cidr}"
+  submask_length = "${ecl_network_public_ip_v2.public_ip_1.submask_length}"
+  description = "a reverse zone"
+}
+
+resource "ecl_dns_ptr_record_v2" "ptr_1" {
+  zone_id = "${ecl_dns_reverse_zone_v2.reverse_zone_1.zones.0.id}"
+  ip_address = "${cidrhost("${ecl_network_public_ip_v2.public_ip_1.cidr}/28", 1)}"
+  ptrdname = "mail.example.com"
+}
+`, testAccDNSV2ReverseZonePublicIP)
+
+var testAccDNSV2ReverseZoneUpdate = fmt.Sprintf(`
+%s
+
+resource "ecl_dns_reverse_zone_v2" "reverse_zone_1" {
+  cidr = "${ecl_network_public_ip_v2.public_ip_1.cidr}"
+  submask_length = "${ecl_network_public_ip_v2.public_ip_1.submask_length}"
+  description = "an updated reverse zone"
+}
+
+resource "ecl_dns_ptr_record_v2" "ptr_1" {
+  zone_id = "${ecl_dns_reverse_zone_v2.reverse_zone_1.zones.0.id}"
+  ip_address = "${cidrhost("${ecl_network_public_ip_v2.public_ip_1.cidr}/28", 1)}"
+  ptrdname = "smtp.example.com."
+  ttl = 600
+}
+`, testAccDNSV2ReverseZonePublicIP)
@@ -0,0 +1,587 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/recordsets"
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/zones"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceDNSZoneRecordsV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSZoneRecordsV2Create,
+		Read:   resourceDNSZoneRecordsV2Read,
+		Update: resourceDNSZoneRecordsV2Update,
+		Delete: resourceDNSZoneRecordsV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_file": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"record"},
+				ValidateFunc:     validateDNSZoneFile,
+				DiffSuppressFunc: suppressDNSZoneFileDiffs,
+			},
+			"record": &schema.Schema{
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"zone_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(dnsZoneFileRecordTypes, false),
+						},
+						"ttl": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"records": &schema.Schema{
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      dnsRecordSetV2RecordHash,
+						},
+					},
+				},
+			},
+			"default_ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			"keep_unmanaged": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"zone_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recordsets": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"records": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDNSZoneRecordsV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	zone, err := zones.Get(dnsClient, zoneID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving ECL DNS zone %s: %s", zoneID, err)
+	}
+
+	zoneFile := d.Get("zone_file").(string)
+	records := d.Get("record").(*schema.Set).List()
+	if zoneFile == "" && len(records) == 0 {
+		return fmt.Errorf("Either zone_file or record must be specified")
+	}
+
+	desired, err := resourceDNSZoneRecordsV2Desired(zoneFile, records, zone.Name, d.Get("default_ttl").(int))
+	if err != nil {
+		return err
+	}
+
+	var managed map[string]bool
+	if d.Get("keep_unmanaged").(bool) {
+		managed = dnsZoneRecordsV2Keys(desired)
+	}
+
+	log.Printf("[DEBUG] Applying records to ECL DNS zone %s: %#v", zoneID, desired)
+	if err := dnsZoneRecordsV2Apply(dnsClient, zoneID, zone.Name, desired, managed, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(zoneID)
+	d.Set("zone_name", zone.Name)
+
+	return resourceDNSZoneRecordsV2Read(d, meta)
+}
+
+func resourceDNSZoneRecordsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	zone, err := zones.Get(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "zone_records")
+	}
+
+	actual, err := dnsZoneRecordsV2List(dnsClient, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("zone_id", zone.ID)
+	d.Set("zone_name", zone.Name)
+
+	if err := d.Set("recordsets", flattenDNSZoneRecordsV2RecordSets(actual)); err != nil {
+		return fmt.Errorf("Unable to set recordsets: %s", err)
+	}
+
+	defaultTTL := d.Get("default_ttl").(int)
+	if defaultTTL == 0 {
+		// The default is not set when the resource is imported.
+		defaultTTL = zone.TTL
+		d.Set("default_ttl", defaultTTL)
+	}
+
+	records := d.Get("record").(*schema.Set).List()
+	desired, err := resourceDNSZoneRecordsV2Desired(d.Get("zone_file").(string), records, zone.Name, defaultTTL)
+	if err != nil {
+		return err
+	}
+
+	var managed map[string]bool
+	if d.Get("keep_unmanaged").(bool) {
+		managed = dnsZoneRecordsV2Keys(desired)
+	}
+
+	current := dnsZoneRecordsV2Managed(actual, zone.Name, managed)
+	if dnsZoneFileRecordSetsEqual(desired, current) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Records of ECL DNS zone %s differ from the configuration: %#v", zone.ID, current)
+	if len(records) > 0 {
+		if err := d.Set("record", flattenDNSZoneRecordsV2Records(current)); err != nil {
+			return fmt.Errorf("Unable to set record: %s", err)
+		}
+		return nil
+	}
+
+	d.Set("zone_file", renderDNSZoneFile(zone.Name, defaultTTL, current))
+
+	return nil
+}
+
+func resourceDNSZoneRecordsV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	zoneName := d.Get("zone_name").(string)
+
+	oldZoneFile, newZoneFile := d.GetChange("zone_file")
+	oldRecords, newRecords := d.GetChange("record")
+	oldTTL, newTTL := d.GetChange("default_ttl")
+
+	if newZoneFile.(string) == "" && newRecords.(*schema.Set).Len() == 0 {
+		return fmt.Errorf("Either zone_file or record must be specified")
+	}
+
+	previous, err := resourceDNSZoneRecordsV2Desired(oldZoneFile.(string), oldRecords.(*schema.Set).List(), zoneName, oldTTL.(int))
+	if err != nil {
+		return err
+	}
+
+	desired, err := resourceDNSZoneRecordsV2Desired(newZoneFile.(string), newRecords.(*schema.Set).List(), zoneName, newTTL.(int))
+	if err != nil {
+		return err
+	}
+
+	// Records removed from the configuration are deleted even when
+	// keep_unmanaged is set, since they were managed by this resource.
+	var managed map[string]bool
+	if d.Get("keep_unmanaged").(bool) {
+		managed = dnsZoneRecordsV2Keys(desired)
+		for k := range dnsZoneRecordsV2Keys(previous) {
+			managed[k] = true
+		}
+	}
+
+	log.Printf("[DEBUG] Applying records to ECL DNS zone %s: %#v", d.Id(), desired)
+	if err := dnsZoneRecordsV2Apply(dnsClient, d.Id(), zoneName, desired, managed, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceDNSZoneRecordsV2Read(d, meta)
+}
+
+func resourceDNSZoneRecordsV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	zone, err := zones.Get(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "zone_records")
+	}
+
+	records := d.Get("record").(*schema.Set).List()
+	desired, err := resourceDNSZoneRecordsV2Desired(d.Get("zone_file").(string), records, zone.Name, d.Get("default_ttl").(int))
+	if err != nil {
+		return err
+	}
+
+	// Only the records of the configuration are deleted,
+	// whether or not keep_unmanaged is set.
+	managed := dnsZoneRecordsV2Keys(desired)
+	if err := dnsZoneRecordsV2Apply(dnsClient, d.Id(), zone.Name, nil, managed, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceDNSZoneRecordsV2Desired returns the record sets of either
+// a zone file or a set of record blocks.
+// SOA and NS records of the zone apex are managed by the DNS service
+// and are left out.
+func resourceDNSZoneRecordsV2Desired(zoneFile string, records []interface{}, zoneName string, defaultTTL int) ([]dnsZoneFileRecordSet, error) {
+	var rrsets []dnsZoneFileRecordSet
+
+	if zoneFile != "" {
+		parsed, err := parseDNSZoneFile(zoneFile, zoneName, defaultTTL)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing zone_file: %s", err)
+		}
+		rrsets = parsed
+	}
+
+	for _, raw := range records {
+		record := raw.(map[string]interface{})
+		rrtype := record["type"].(string)
+		rs := dnsZoneFileRecordSet{
+			Name: dnsZoneFileFQDN(record["name"].(string), dnsZoneFileFQDN(zoneName, "")),
+			Type: rrtype,
+			TTL:  record["ttl"].(int),
+		}
+		for _, r := range record["records"].(*schema.Set).List() {
+			rs.Records = append(rs.Records, r.(string))
+		}
+		rrsets = append(rrsets, rs)
+	}
+
+	var desired []dnsZoneFileRecordSet
+	keys := make(map[string]bool)
+	types := make(map[string][]string)
+	for _, rs := range rrsets {
+		if keys[rs.key()] {
+			return nil, fmt.Errorf("Duplicated record %s %s", rs.Name, rs.Type)
+		}
+		keys[rs.key()] = true
+
+		for i, r := range rs.Records {
+			if err := dnsRecordSetV2ValidateRecord(rs.Type, r); err != nil {
+				return nil, fmt.Errorf("Invalid record %s %s: %s", rs.Name, rs.Type, err)
+			}
+			rs.Records[i] = dnsRecordSetV2NormalizeRecord(rs.Type, r, zoneName)
+		}
+
+		name := strings.ToLower(rs.Name)
+		types[name] = append(types[name], rs.Type)
+		if len(types[name]) > 1 && dnsZoneFileContains(types[name], "CNAME") {
+			return nil, fmt.Errorf("A CNAME record can not coexist with other records of the same name: %s", rs.Name)
+		}
+
+		if dnsZoneRecordsV2Protected(rs.Name, rs.Type, zoneName) {
+			log.Printf("[DEBUG] Ignoring %s %s record managed by the DNS service", rs.Name, rs.Type)
+			continue
+		}
+		desired = append(desired, rs)
+	}
+
+	return desired, nil
+}
+
+// dnsZoneRecordsV2Apply creates, updates and deletes record sets of a zone
+// so that the record sets in managed match desired. When managed is nil,
+// every record set of the zone is managed.
+func dnsZoneRecordsV2Apply(client *eclcloud.ServiceClient, zoneID, zoneName string, desired []dnsZoneFileRecordSet, managed map[string]bool, timeout time.Duration) error {
+	actual, err := dnsZoneRecordsV2List(client, zoneID)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string][]recordsets.RecordSet)
+	for _, rs := range actual {
+		k := dnsZoneFileKey(dnsZoneFileFQDN(rs.Name, ""), rs.Type)
+		existing[k] = append(existing[k], rs)
+	}
+
+	for _, want := range desired {
+		k := want.key()
+		have := existing[k]
+		delete(existing, k)
+
+		if len(have) == 0 {
+			createOpts := RecordSetCreateOpts{
+				recordsets.CreateOpts{
+					Name:    want.Name,
+					Records: want.Records,
+					TTL:     want.TTL,
+					Type:    want.Type,
+				},
+			}
+
+			log.Printf("[DEBUG] Creating ECL DNS record set in zone %s: %#v", zoneID, createOpts)
+			if _, err := recordsets.Create(client, zoneID, createOpts).ExtractCreatedRecordSet(); err != nil {
+				return fmt.Errorf("Error creating ECL DNS record set %s %s: %s", want.Name, want.Type, err)
+			}
+			continue
+		}
+
+		// Additional record sets of the same name and type are merged
+		// into the first one.
+		for _, rs := range have[1:] {
+			log.Printf("[DEBUG] Deleting duplicated ECL DNS record set %s", rs.ID)
+			if err := recordsets.Delete(client, zoneID, rs.ID).ExtractErr(); err != nil {
+				return fmt.Errorf("Error deleting ECL DNS record set %s: %s", rs.ID, err)
+			}
+		}
+
+		if dnsZoneFileRecordSetEqual(want, dnsZoneRecordsV2RecordSet(have[0])) {
+			continue
+		}
+
+		// All parameters are sent, see resourceDNSRecordSetV2Update.
+		name := want.Name
+		ttl := want.TTL
+		records := want.Records
+		updateOpts := recordsets.UpdateOpts{
+			Name:    &name,
+			TTL:     &ttl,
+			Records: &records,
+		}
+
+		log.Printf("[DEBUG] Updating ECL DNS record set %s with options: %#v", have[0].ID, updateOpts)
+		if _, err := recordsets.Update(client, zoneID, have[0].ID, updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating ECL DNS record set %s: %s", have[0].ID, err)
+		}
+	}
+
+	for k, rss := range existing {
+		if managed != nil && !managed[k] {
+			continue
+		}
+		for _, rs := range rss {
+			if dnsZoneRecordsV2Protected(rs.Name, rs.Type, zoneName) {
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting ECL DNS record set %s", rs.ID)
+			if err := recordsets.Delete(client, zoneID, rs.ID).ExtractErr(); err != nil {
+				return fmt.Errorf("Error deleting ECL DNS record set %s: %s", rs.ID, err)
+			}
+		}
+	}
+
+	// ECL2.0 DNS API does not reflect changes just after they are made.
+	return resource.Retry(timeout, func() *resource.RetryError {
+		actual, err := dnsZoneRecordsV2List(client, zoneID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		current := dnsZoneRecordsV2Managed(actual, zoneName, managed)
+		if !dnsZoneFileRecordSetsEqual(desired, current) {
+			return resource.RetryableError(fmt.Errorf("Records of ECL DNS zone %s are not updated yet", zoneID))
+		}
+
+		return nil
+	})
+}
+
+// dnsZoneRecordsV2List returns all record sets of a zone.
+func dnsZoneRecordsV2List(client *eclcloud.ServiceClient, zoneID string) ([]recordsets.RecordSet, error) {
+	pages, err := recordsets.ListByZone(client, zoneID, nil).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve record sets of ECL DNS zone %s: %s", zoneID, err)
+	}
+
+	allRecordSets, err := recordsets.ExtractRecordSets(pages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to extract record sets of ECL DNS zone %s: %s", zoneID, err)
+	}
+
+	return allRecordSets, nil
+}
+
+// dnsZoneRecordsV2Managed returns the record sets in managed, merging record
+// sets of the same name and type. When managed is nil, every record set
+// except those managed by the DNS service is returned.
+func dnsZoneRecordsV2Managed(actual []recordsets.RecordSet, zoneName string, managed map[string]bool) []dnsZoneFileRecordSet {
+	var result []dnsZoneFileRecordSet
+	index := make(map[string]int)
+
+	for _, raw := range actual {
+		rs := dnsZoneRecordsV2RecordSet(raw)
+		if dnsZoneRecordsV2Protected(rs.Name, rs.Type, zoneName) {
+			continue
+		}
+
+		k := rs.key()
+		if managed != nil && !managed[k] {
+			continue
+		}
+
+		if i, ok := index[k]; ok {
+			result[i].Records = append(result[i].Records, rs.Records...)
+			continue
+		}
+
+		index[k] = len(result)
+		result = append(result, rs)
+	}
+
+	return result
+}
+
+func dnsZoneRecordsV2RecordSet(rs recordsets.RecordSet) dnsZoneFileRecordSet {
+	return dnsZoneFileRecordSet{
+		Name:    dnsZoneFileFQDN(rs.Name, ""),
+		Type:    strings.ToUpper(rs.Type),
+		TTL:     rs.TTL,
+		Records: dnsRecordSetV2Records(&rs),
+	}
+}
+
+func dnsZoneRecordsV2Keys(rrsets []dnsZoneFileRecordSet) map[string]bool {
+	keys := make(map[string]bool, len(rrsets))
+	for _, rs := range rrsets {
+		keys[rs.key()] = true
+	}
+	return keys
+}
+
+// dnsZoneRecordsV2Protected reports whether a record set is managed by
+// the DNS service itself.
+func dnsZoneRecordsV2Protected(name, rrtype, zoneName string) bool {
+	switch strings.ToUpper(rrtype) {
+	case "SOA":
+		return true
+	case "NS":
+		return dnsZoneFileFQDN(name, "") == dnsZoneFileFQDN(zoneName, "")
+	}
+	return false
+}
+
+func flattenDNSZoneRecordsV2RecordSets(actual []recordsets.RecordSet) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(actual))
+	for _, rs := range actual {
+		result = append(result, map[string]interface{}{
+			"id":      rs.ID,
+			"name":    rs.Name,
+			"type":    rs.Type,
+			"ttl":     rs.TTL,
+			"records": dnsRecordSetV2Records(&rs),
+		})
+	}
+	return result
+}
+
+func flattenDNSZoneRecordsV2Records(rrsets []dnsZoneFileRecordSet) []interface{} {
+	result := make([]interface{}, 0, len(rrsets))
+	for _, rs := range rrsets {
+		records := make([]interface{}, len(rs.Records))
+		for i, r := range rs.Records {
+			records[i] = r
+		}
+		result = append(result, map[string]interface{}{
+			"name":    rs.Name,
+			"type":    rs.Type,
+			"ttl":     rs.TTL,
+			"records": schema.NewSet(dnsRecordSetV2RecordHash, records),
+		})
+	}
+	return result
+}
+
+func validateDNSZoneFile(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseDNSZoneFile(v.(string), "", 0); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid zone file: %s", k, err))
+	}
+	return
+}
+
+// suppressDNSZoneFileDiffs suppresses diffs between zone files that
+// describe the same records, e.g. differing only in comments, ordering,
+// relative names or the record sets managed by the DNS service.
+func suppressDNSZoneFileDiffs(k, old, new string, d *schema.ResourceData) bool {
+	zoneName := d.Get("zone_name").(string)
+	if zoneName == "" || old == "" || new == "" {
+		return false
+	}
+
+	defaultTTL := d.Get("default_ttl").(int)
+	o, err := resourceDNSZoneRecordsV2Desired(old, nil, zoneName, defaultTTL)
+	if err != nil {
+		return false
+	}
+	n, err := resourceDNSZoneRecordsV2Desired(new, nil, zoneName, defaultTTL)
+	if err != nil {
+		return false
+	}
+
+	return dnsZoneFileRecordSetsEqual(o, n)
+}
@@ -0,0 +1,330 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedDNSV2ZoneRecords_zoneFile(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "zone", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff", testMockDNSV2ZoneRecordsGetZone)
+	mc.Register(t, "records", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2ZoneRecordsListAfterCreate)
+	mc.Register(t, "records", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2ZoneRecordsListAfterUpdate)
+	mc.Register(t, "records", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2ZoneRecordsListAfterDelete)
+	mc.Register(t, "records", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2ZoneRecordsList)
+	mc.Register(t, "records", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets", testMockDNSV2ZoneRecordsCreate)
+	mc.Register(t, "records", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/0f3e9a4c-6a1e-4f64-9c8b-5f8e2f1f6d10", testMockDNSV2ZoneRecordsDeleteUnmanaged)
+	mc.Register(t, "records", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01", testMockDNSV2ZoneRecordsUpdate)
+	mc.Register(t, "records", "/v2/zones/cebb1607-40c2-466b-b76b-9fcc7a356bff/recordsets/a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01", testMockDNSV2ZoneRecordsDelete)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2ZoneRecordsDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockDNSV2ZoneRecordsZoneFile,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneRecordsExists("ecl_dns_zone_records_v2.records_1", 1),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_zone_records_v2.records_1", "zone_name", "example.com."),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_zone_records_v2.records_1", "recordsets.#", "3"),
+				),
+			},
+			resource.TestStep{
+				Config: testMockDNSV2ZoneRecordsZoneFileUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneRecordsExists("ecl_dns_zone_records_v2.records_1", 1),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_zone_records_v2.records_1", "recordsets.2.ttl", "600"),
+				),
+			},
+		},
+	})
+}
+
+const testMockDNSV2ZoneRecordsZoneFile = `
+resource "ecl_dns_zone_records_v2" "records_1" {
+  zone_id = "cebb1607-40c2-466b-b76b-9fcc7a356bff"
+  keep_unmanaged = false
+  zone_file = <<EOF
+$ORIGIN example.com.
+@    IN  NS  ns1.example.com.
+www  300  IN  A  10.1.0.1 ; web servers
+          IN  A  10.1.0.2
+EOF
+}
+`
+
+const testMockDNSV2ZoneRecordsZoneFileUpdate = `
+resource "ecl_dns_zone_records_v2" "records_1" {
+  zone_id = "cebb1607-40c2-466b-b76b-9fcc7a356bff"
+  keep_unmanaged = false
+  zone_file = <<EOF
+$ORIGIN example.com.
+@    IN  NS  ns1.example.com.
+www  600  IN  A  10.1.0.1 ; web servers
+          IN  A  10.1.0.2
+EOF
+}
+`
+
+var testMockDNSV2ZoneRecordsGetZone = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+            "pool_id": "",
+            "project_id": "9ee80f2a926c49f88f166af47df4e9f5",
+            "name": "example.com.",
+            "email": "",
+            "ttl": 3600,
+            "serial": 1,
+            "status": "ACTIVE",
+            "action": "",
+            "description": "",
+            "masters": [],
+            "type": "",
+            "transferred_at": null,
+            "version": 1,
+            "created_at": "2019-01-01T00:00:00.000000",
+            "updated_at": null,
+            "links": {}
+        }
+`
+
+var testMockDNSV2ZoneRecordsList = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "recordsets": [
+                {
+                    "id": "3b0f5e6a-2a86-4bd4-a4e4-8f6b3c0b1e01",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "example.com.",
+                    "type": "SOA",
+                    "ttl": 3600,
+                    "records": ["ns1.example.com. admin.example.com. 1 3600 600 86400 3600"],
+                    "description": ""
+                },
+                {
+                    "id": "3b0f5e6a-2a86-4bd4-a4e4-8f6b3c0b1e02",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "example.com.",
+                    "type": "NS",
+                    "ttl": 3600,
+                    "records": ["ns1.example.com."],
+                    "description": ""
+                },
+                {
+                    "id": "0f3e9a4c-6a1e-4f64-9c8b-5f8e2f1f6d10",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "legacy.example.com.",
+                    "type": "A",
+                    "ttl": 3600,
+                    "records": ["10.1.0.100"],
+                    "description": ""
+                }
+            ],
+            "links": {}
+        }
+`
+
+var testMockDNSV2ZoneRecordsCreate = `
+request:
+    method: POST
+    body: >
+        {"name":"www.example.com.","records":["10.1.0.1","10.1.0.2"],"ttl":300,"type":"A"}
+response:
+    code: 201
+    body: >
+        {
+            "recordsets": [
+                {
+                    "id": "a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "www.example.com.",
+                    "type": "A",
+                    "ttl": 300,
+                    "records": ["10.1.0.1", "10.1.0.2"],
+                    "description": ""
+                }
+            ]
+        }
+newStatus: Created
+`
+
+var testMockDNSV2ZoneRecordsDeleteUnmanaged = `
+request:
+    method: DELETE
+response:
+    code: 204
+expectedStatus:
+    - Created
+`
+
+var testMockDNSV2ZoneRecordsListAfterCreate = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "recordsets": [
+                {
+                    "id": "3b0f5e6a-2a86-4bd4-a4e4-8f6b3c0b1e01",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "example.com.",
+                    "type": "SOA",
+                    "ttl": 3600,
+                    "records": ["ns1.example.com. admin.example.com. 2 3600 600 86400 3600"],
+                    "description": ""
+                },
+                {
+                    "id": "3b0f5e6a-2a86-4bd4-a4e4-8f6b3c0b1e02",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "example.com.",
+                    "type": "NS",
+                    "ttl": 3600,
+                    "records": ["ns1.example.com."],
+                    "description": ""
+                },
+                {
+                    "id": "a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "www.example.com.",
+                    "type": "A",
+                    "ttl": 300,
+                    "records": ["10.1.0.2", "10.1.0.1"],
+                    "description": ""
+                }
+            ],
+            "links": {}
+        }
+expectedStatus:
+    - Created
+`
+
+var testMockDNSV2ZoneRecordsUpdate = `
+request:
+    method: PUT
+    body: >
+        {"name":"www.example.com.","records":["10.1.0.1","10.1.0.2"],"ttl":600}
+response:
+    code: 200
+    body: >
+        {
+            "id": "a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01",
+            "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+            "name": "www.example.com.",
+            "type": "A",
+            "ttl": 600,
+            "records": ["10.1.0.1", "10.1.0.2"],
+            "description": ""
+        }
+expectedStatus:
+    - Created
+newStatus: Updated
+`
+
+var testMockDNSV2ZoneRecordsListAfterUpdate = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "recordsets": [
+                {
+                    "id": "3b0f5e6a-2a86-4bd4-a4e4-8f6b3c0b1e01",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "example.com.",
+                    "type": "SOA",
+                    "ttl": 3600,
+                    "records": ["ns1.example.com. admin.example.com. 3 3600 600 86400 3600"],
+                    "description": ""
+                },
+                {
+                    "id": "3b0f5e6a-2a86-4bd4-a4e4-8f6b3c0b1e02",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "example.com.",
+                    "type": "NS",
+                    "ttl": 3600,
+                    "records": ["ns1.example.com."],
+                    "description": ""
+                },
+                {
+                    "id": "a692d9e5-bbc4-4b8d-8f5b-1f2a3f6e8a01",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "www.example.com.",
+                    "type": "A",
+                    "ttl": 600,
+                    "records": ["10.1.0.1", "10.1.0.2"],
+                    "description": ""
+                }
+            ],
+            "links": {}
+        }
+expectedStatus:
+    - Updated
+`
+
+var testMockDNSV2ZoneRecordsDelete = `
+request:
+    method: DELETE
+response:
+    code: 204
+expectedStatus:
+    - Updated
+newStatus: Deleted
+`
+
+var testMockDNSV2ZoneRecordsListAfterDelete = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "recordsets": [
+                {
+                    "id": "3b0f5e6a-2a86-4bd4-a4e4-8f6b3c0b1e01",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "example.com.",
+                    "type": "SOA",
+                    "ttl": 3600,
+                    "records": ["ns1.example.com. admin.example.com. 4 3600 600 86400 3600"],
+                    "description": ""
+                },
+                {
+                    "id": "3b0f5e6a-2a86-4bd4-a4e4-8f6b3c0b1e02",
+                    "zone_id": "cebb1607-40c2-466b-b76b-9fcc7a356bff",
+                    "name": "example.com.",
+                    "type": "NS",
+                    "ttl": 3600,
+                    "records": ["ns1.example.com."],
+                    "description": ""
+                }
+            ],
+            "links": {}
+        }
+expectedStatus:
+    - Deleted
+`
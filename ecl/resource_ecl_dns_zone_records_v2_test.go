@@ -0,0 +1,177 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/nttcom/eclcloud/v3/ecl/dns/v2/zones"
+)
+
+func TestAccDNSV2ZoneRecords_zoneFile(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2ZoneRecordsDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2ZoneRecordsZoneFile(zoneName, "300"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneRecordsExists("ecl_dns_zone_records_v2.records_1", 3),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_zone_records_v2.records_1", "zone_name", zoneName),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDNSV2ZoneRecordsZoneFile(zoneName, "600"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneRecordsExists("ecl_dns_zone_records_v2.records_1", 3),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDNSV2ZoneRecords_record(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2ZoneRecordsDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2ZoneRecordsRecord(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneRecordsExists("ecl_dns_zone_records_v2.records_1", 2),
+					resource.TestCheckResourceAttr(
+						"ecl_dns_zone_records_v2.records_1", "record.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2ZoneRecordsDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating ECL DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ecl_dns_zone_records_v2" {
+			continue
+		}
+
+		zone, err := zones.Get(dnsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			// The zone itself has been destroyed.
+			continue
+		}
+
+		actual, err := dnsZoneRecordsV2List(dnsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(dnsZoneRecordsV2Managed(actual, zone.Name, nil)) != 0 {
+			return fmt.Errorf("Zone records still exist")
+		}
+	}
+
+	return nil
+}
+
+// testAccCheckDNSV2ZoneRecordsExists checks the number of record sets in
+// the zone, except those managed by the DNS service.
+func testAccCheckDNSV2ZoneRecordsExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating ECL DNS client: %s", err)
+		}
+
+		zone, err := zones.Get(dnsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		actual, err := dnsZoneRecordsV2List(dnsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if n := len(dnsZoneRecordsV2Managed(actual, zone.Name, nil)); n != count {
+			return fmt.Errorf("Expected %d record sets, got %d", count, n)
+		}
+
+		return nil
+	}
+}
+
+func testAccDNSV2ZoneRecordsZoneFile(zoneName, ttl string) string {
+	return fmt.Sprintf(`
+		resource "ecl_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email2@example.com"
+			ttl = 6000
+			type = "PRIMARY"
+		}
+
+		resource "ecl_dns_zone_records_v2" "records_1" {
+			zone_id = "${ecl_dns_zone_v2.zone_1.id}"
+			keep_unmanaged = false
+			zone_file = <<EOF
+$TTL 3600
+www   %s  IN  A      10.1.0.1
+      %s  IN  A      10.1.0.2
+mail      IN  MX     10 mx1
+mx1       IN  A      10.1.0.10
+EOF
+		}
+	`, zoneName, ttl, ttl)
+}
+
+func testAccDNSV2ZoneRecordsRecord(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "ecl_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email2@example.com"
+			ttl = 6000
+			type = "PRIMARY"
+		}
+
+		resource "ecl_dns_zone_records_v2" "records_1" {
+			zone_id = "${ecl_dns_zone_v2.zone_1.id}"
+
+			record {
+				name = "www.%s"
+				type = "A"
+				ttl = 300
+				records = ["10.1.0.1", "10.1.0.2"]
+			}
+
+			record {
+				name = "txt.%s"
+				type = "TXT"
+				ttl = 300
+				records = ["\"hello\""]
+			}
+		}
+	`, zoneName, zoneName, zoneName)
+}
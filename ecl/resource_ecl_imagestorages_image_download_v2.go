@@ -0,0 +1,193 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/imagestorage/v2/images"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImageStoragesImageDownloadV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImageStoragesImageDownloadV2Create,
+		Read:   resourceImageStoragesImageDownloadV2Read,
+		Update: resourceImageStoragesImageDownloadV2Update,
+		Delete: resourceImageStoragesImageDownloadV2Delete,
+
+		CustomizeDiff: resourceImageStoragesImageDownloadV2CustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"local_file_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"verify_checksum": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"keep_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Computed-only
+			"checksum": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size_bytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImageStoragesImageDownloadV2Create(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceImageStoragesImageDownloadV2Download(d, meta); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("local_file_path").(string))
+
+	return resourceImageStoragesImageDownloadV2Read(d, meta)
+}
+
+// resourceImageStoragesImageDownloadV2Read checks only the local file, so that
+// the file is kept in the state even after the image is deleted.
+func resourceImageStoragesImageDownloadV2Read(d *schema.ResourceData, meta interface{}) error {
+	filename := d.Get("local_file_path").(string)
+
+	fstat, err := os.Stat(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Printf("[DEBUG] Downloaded image %s is not found", filename)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading downloaded image %q: %s", filename, err)
+	}
+
+	if size := int64(d.Get("size_bytes").(int)); size > 0 && fstat.Size() != size {
+		log.Printf("[DEBUG] Downloaded image %s has %d bytes, expected %d bytes", filename, fstat.Size(), size)
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceImageStoragesImageDownloadV2Update(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("checksum") {
+		if err := resourceImageStoragesImageDownloadV2Download(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceImageStoragesImageDownloadV2Read(d, meta)
+}
+
+func resourceImageStoragesImageDownloadV2Delete(d *schema.ResourceData, meta interface{}) error {
+	filename := d.Get("local_file_path").(string)
+
+	if d.Get("keep_on_destroy").(bool) {
+		log.Printf("[DEBUG] Keeping downloaded image %s", filename)
+	} else {
+		log.Printf("[DEBUG] Deleting downloaded image %s", filename)
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("Error deleting downloaded image %q: %s", filename, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceImageStoragesImageDownloadV2Download(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	img, err := images.Get(imageClient, imageID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving image %s: %s", imageID, err)
+	}
+
+	if img.Status != images.ImageStatusActive {
+		return fmt.Errorf("Image %s is not active: %s", imageID, img.Status)
+	}
+
+	err = imageStoragesImageV2Download(
+		imageClient, imageID, img.Checksum, img.SizeBytes,
+		d.Get("local_file_path").(string), d.Get("verify_checksum").(bool))
+	if err != nil {
+		return err
+	}
+
+	d.Set("checksum", img.Checksum)
+	d.Set("size_bytes", img.SizeBytes)
+
+	return nil
+}
+
+// resourceImageStoragesImageDownloadV2CustomizeDiff plans downloading the
+// image again only if its checksum differs from the downloaded one.
+// Changing image_id to an image with the same data does not download it.
+func resourceImageStoragesImageDownloadV2CustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("image_id") {
+		return d.SetNewComputed("checksum")
+	}
+
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(config.Region)
+	if err != nil {
+		return fmt.Errorf("Error creating ECL image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	img, err := images.Get(imageClient, imageID).Extract()
+	if err != nil {
+		if _, ok := err.(eclcloud.ErrDefault404); ok && !d.HasChange("image_id") {
+			log.Printf("[DEBUG] Image %s is not found, keeping downloaded image %s", imageID, d.Id())
+			return nil
+		}
+		return fmt.Errorf("Error retrieving image %s: %s", imageID, err)
+	}
+
+	if img.Checksum != d.Get("checksum").(string) {
+		if err := d.SetNew("checksum", img.Checksum); err != nil {
+			return err
+		}
+		return d.SetNew("size_bytes", img.SizeBytes)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package ecl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedImageStoragesV2ImageDownload_basic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "image_download")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "image.img")
+
+	// A download interrupted after 5 bytes, which is resumed.
+	part := filename + ".5a6a754d94a57f6ff647689acaf0a51f.part"
+	if err := ioutil.WriteFile(part, []byte("Dummy"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "image1", "/v2/images/7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f01", testMockImageStoragesV2ImageDownloadGet1)
+	mc.Register(t, "image1", "/v2/images/7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f01/file", testMockImageStoragesV2ImageDownloadResume)
+	mc.Register(t, "image2", "/v2/images/7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f02", testMockImageStoragesV2ImageDownloadGet2)
+	mc.Register(t, "image3", "/v2/images/7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f03", testMockImageStoragesV2ImageDownloadGet3)
+	mc.Register(t, "image3", "/v2/images/7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f03/file", testMockImageStoragesV2ImageDownloadFile)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImageStoragesV2ImageDownloadDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockImageStoragesV2ImageDownload("7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f01", filename),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageStoragesV2ImageDownloadContent("ecl_imagestorages_image_download_v2.download_1", "DummyContent\n"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_download_v2.download_1", "checksum", "5a6a754d94a57f6ff647689acaf0a51f"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_download_v2.download_1", "size_bytes", "13"),
+				),
+			},
+			// The image has the same checksum, so it is not downloaded.
+			resource.TestStep{
+				Config: testMockImageStoragesV2ImageDownload("7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f02", filename),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageStoragesV2ImageDownloadContent("ecl_imagestorages_image_download_v2.download_1", "DummyContent\n"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_download_v2.download_1", "image_id", "7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f02"),
+				),
+			},
+			resource.TestStep{
+				Config: testMockImageStoragesV2ImageDownload("7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f03", filename),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageStoragesV2ImageDownloadContent("ecl_imagestorages_image_download_v2.download_1", "UpdatedContent\n"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_download_v2.download_1", "checksum", "11fcbbd7a210faf208984c794b46e581"),
+				),
+			},
+		},
+	})
+}
+
+func testMockImageStoragesV2ImageDownload(imageID, filename string) string {
+	return fmt.Sprintf(`
+resource "ecl_imagestorages_image_download_v2" "download_1" {
+  image_id = "%s"
+  local_file_path = "%s"
+}
+`, imageID, filename)
+}
+
+var testMockImageStoragesV2ImageDownloadGet1 = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f01",
+            "name": "Temp Terraform AccTest",
+            "status": "active",
+            "tags": [],
+            "container_format": "bare",
+            "disk_format": "raw",
+            "min_disk": 0,
+            "min_ram": 0,
+            "owner": "01234567890123456789abcdefabcdef",
+            "protected": false,
+            "visibility": "private",
+            "checksum": "5a6a754d94a57f6ff647689acaf0a51f",
+            "size": 13,
+            "created_at": "2019-01-01T00:00:00Z",
+            "updated_at": "2019-01-01T00:00:00Z",
+            "file": "/v2/images/7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f01/file",
+            "schema": "/v2/schemas/image"
+        }
+`
+
+var testMockImageStoragesV2ImageDownloadGet2 = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f02",
+            "name": "Temp Terraform AccTest",
+            "status": "active",
+            "tags": [],
+            "container_format": "bare",
+            "disk_format": "raw",
+            "min_disk": 0,
+            "min_ram": 0,
+            "owner": "01234567890123456789abcdefabcdef",
+            "protected": false,
+            "visibility": "private",
+            "checksum": "5a6a754d94a57f6ff647689acaf0a51f",
+            "size": 13,
+            "created_at": "2019-01-01T00:00:00Z",
+            "updated_at": "2019-01-01T00:00:00Z",
+            "file": "/v2/images/7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f02/file",
+            "schema": "/v2/schemas/image"
+        }
+`
+
+var testMockImageStoragesV2ImageDownloadGet3 = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "id": "7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f03",
+            "name": "Temp Terraform AccTest",
+            "status": "active",
+            "tags": [],
+            "container_format": "bare",
+            "disk_format": "raw",
+            "min_disk": 0,
+            "min_ram": 0,
+            "owner": "01234567890123456789abcdefabcdef",
+            "protected": false,
+            "visibility": "private",
+            "checksum": "11fcbbd7a210faf208984c794b46e581",
+            "size": 15,
+            "created_at": "2019-01-01T00:00:00Z",
+            "updated_at": "2019-01-01T00:00:00Z",
+            "file": "/v2/images/7d6e1f3a-0b2c-4d5e-8f9a-1b2c3d4e5f03/file",
+            "schema": "/v2/schemas/image"
+        }
+`
+
+var testMockImageStoragesV2ImageDownloadResume = `
+request:
+    method: GET
+response:
+    code: 206
+    body: >
+        Content
+`
+
+var testMockImageStoragesV2ImageDownloadFile = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        UpdatedContent
+`
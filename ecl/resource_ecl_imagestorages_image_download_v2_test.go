@@ -0,0 +1,90 @@
+package ecl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const localFileForDownloadTest = "/tmp/tempfile_download.img"
+
+func TestAccImageStoragesV2ImageDownload_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			createTemporalImage(localFileForResourceTest)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImageStoragesV2ImageDownloadDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImageStoragesV2ImageDownloadBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageStoragesV2ImageDownloadContent("ecl_imagestorages_image_download_v2.download_1", "DummyContent\n"),
+					resource.TestCheckResourceAttrPair(
+						"ecl_imagestorages_image_download_v2.download_1", "checksum",
+						"ecl_imagestorages_image_v2.image_1", "checksum"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageStoragesV2ImageDownloadDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ecl_imagestorages_image_download_v2" {
+			continue
+		}
+
+		if _, err := os.Stat(rs.Primary.ID); err == nil {
+			return fmt.Errorf("Downloaded image still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImageStoragesV2ImageDownloadContent(n, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		content, err := ioutil.ReadFile(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if string(content) != expected {
+			return fmt.Errorf("Downloaded image has %q, expected %q", content, expected)
+		}
+
+		return nil
+	}
+}
+
+var testAccImageStoragesV2ImageDownloadBasic = fmt.Sprintf(`
+  resource "ecl_imagestorages_image_v2" "image_1" {
+      name = "Temp Terraform AccTest"
+      local_file_path = "%s"
+      container_format = "bare"
+      disk_format = "raw"
+  }
+
+  resource "ecl_imagestorages_image_download_v2" "download_1" {
+      image_id = "${ecl_imagestorages_image_v2.image_1.id}"
+      local_file_path = "%s"
+  }`, localFileForResourceTest, localFileForDownloadTest)
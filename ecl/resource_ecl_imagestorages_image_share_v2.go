@@ -0,0 +1,324 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/imagestorage/v2/members"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImageStoragesImageShareV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImageStoragesImageShareV2Create,
+		Read:   resourceImageStoragesImageShareV2Read,
+		Update: resourceImageStoragesImageShareV2Update,
+		Delete: resourceImageStoragesImageShareV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"accepter": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"cloud": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"auth_url": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"user_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"password": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"members": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"member_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceImageStoragesImageShareV2Create(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceImageStoragesImageShareV2Reconcile(d, meta); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("image_id").(string))
+
+	return resourceImageStoragesImageShareV2Read(d, meta)
+}
+
+func resourceImageStoragesImageShareV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL image client: %s", err)
+	}
+
+	imageMembers, err := imageStoragesImageShareV2ListMembers(imageClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "image share")
+	}
+
+	log.Printf("[DEBUG] Retrieved members of image %s: %#v", d.Id(), imageMembers)
+
+	memberIDs := make([]string, len(imageMembers))
+	shareMembers := make([]map[string]interface{}, len(imageMembers))
+	for i, m := range imageMembers {
+		memberIDs[i] = m.MemberID
+		shareMembers[i] = map[string]interface{}{
+			"member_id": m.MemberID,
+			"status":    m.Status,
+		}
+	}
+
+	d.Set("image_id", d.Id())
+	d.Set("member_ids", memberIDs)
+	d.Set("members", shareMembers)
+
+	return nil
+}
+
+func resourceImageStoragesImageShareV2Update(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("member_ids") || d.HasChange("accepter") {
+		if err := resourceImageStoragesImageShareV2Reconcile(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceImageStoragesImageShareV2Read(d, meta)
+}
+
+func resourceImageStoragesImageShareV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL image client: %s", err)
+	}
+
+	imageMembers, err := imageStoragesImageShareV2ListMembers(imageClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "image share")
+	}
+
+	for _, m := range imageMembers {
+		log.Printf("[DEBUG] Deleting member %s of image %s", m.MemberID, d.Id())
+		if err := members.Delete(imageClient, d.Id(), m.MemberID).ExtractErr(); err != nil {
+			if _, ok := err.(eclcloud.ErrDefault404); !ok {
+				return fmt.Errorf("Error deleting member %s of image %s: %s", m.MemberID, d.Id(), err)
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceImageStoragesImageShareV2Reconcile adds and removes the members of
+// the image so that they match member_ids, and accepts the image on behalf
+// of the members having an accepter block.
+func resourceImageStoragesImageShareV2Reconcile(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	memberIDs := d.Get("member_ids").(*schema.Set)
+
+	accepters := make(map[string]map[string]interface{})
+	for _, v := range d.Get("accepter").([]interface{}) {
+		accepter := v.(map[string]interface{})
+		tenantID := accepter["tenant_id"].(string)
+		if !memberIDs.Contains(tenantID) {
+			return fmt.Errorf("Accepter tenant %s is not in member_ids", tenantID)
+		}
+		accepters[tenantID] = accepter
+	}
+
+	imageMembers, err := imageStoragesImageShareV2ListMembers(imageClient, imageID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving members of image %s: %s", imageID, err)
+	}
+
+	statuses := make(map[string]string)
+	for _, m := range imageMembers {
+		if memberIDs.Contains(m.MemberID) {
+			statuses[m.MemberID] = m.Status
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting member %s of image %s", m.MemberID, imageID)
+		if err := members.Delete(imageClient, imageID, m.MemberID).ExtractErr(); err != nil {
+			return fmt.Errorf("Error deleting member %s of image %s: %s", m.MemberID, imageID, err)
+		}
+	}
+
+	for _, v := range memberIDs.List() {
+		memberID := v.(string)
+		if _, ok := statuses[memberID]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Creating member %s of image %s", memberID, imageID)
+		m, err := members.Create(imageClient, imageID, memberID).Extract()
+		if err != nil {
+			return fmt.Errorf("Error creating member %s of image %s: %s", memberID, imageID, err)
+		}
+		statuses[memberID] = m.Status
+	}
+
+	for tenantID, accepter := range accepters {
+		if statuses[tenantID] == "accepted" {
+			continue
+		}
+
+		if err := imageStoragesImageShareV2Accept(config, accepter, imageID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// imageStoragesImageShareV2Accept authenticates as the tenant of the accepter
+// block and accepts the image.
+func imageStoragesImageShareV2Accept(config *Config, accepter map[string]interface{}, imageID string) error {
+	tenantID := accepter["tenant_id"].(string)
+
+	accepterConfig, err := imageStoragesImageShareV2AccepterConfig(config, accepter)
+	if err != nil {
+		return err
+	}
+
+	if err := accepterConfig.LoadAndValidate(); err != nil {
+		return fmt.Errorf("Error authenticating as accepter tenant %s: %s", tenantID, err)
+	}
+
+	imageClient, err := accepterConfig.imageV2Client(accepterConfig.Region)
+	if err != nil {
+		return fmt.Errorf("Error creating ECL image client for accepter tenant %s: %s", tenantID, err)
+	}
+
+	log.Printf("[DEBUG] Accepting image %s as tenant %s", imageID, tenantID)
+	updateOpts := members.UpdateOpts{Status: "accepted"}
+	if _, err := members.Update(imageClient, imageID, tenantID, updateOpts).Extract(); err != nil {
+		return fmt.Errorf("Error accepting image %s as tenant %s: %s", imageID, tenantID, err)
+	}
+
+	return nil
+}
+
+// imageStoragesImageShareV2AccepterConfig builds the configuration of the
+// accepter tenant. The TLS settings, the region and, unless a cloud is given,
+// the credentials are inherited from the provider.
+func imageStoragesImageShareV2AccepterConfig(config *Config, accepter map[string]interface{}) (*Config, error) {
+	c := &Config{
+		CACertFile:     config.CACertFile,
+		ClientCertFile: config.ClientCertFile,
+		ClientKeyFile:  config.ClientKeyFile,
+		EndpointType:   config.EndpointType,
+		Insecure:       config.Insecure,
+		Region:         config.Region,
+	}
+
+	if cloud := accepter["cloud"].(string); cloud != "" {
+		c.Cloud = cloud
+		return c, nil
+	}
+
+	if config.Cloud != "" && accepter["auth_url"].(string) == "" {
+		return nil, fmt.Errorf(
+			"One of 'auth_url' or 'cloud' must be specified in accepter of tenant %s, because the provider uses a cloud",
+			accepter["tenant_id"].(string))
+	}
+
+	c.DefaultDomain = config.DefaultDomain
+	c.DomainID = config.DomainID
+	c.DomainName = config.DomainName
+	c.IdentityEndpoint = config.IdentityEndpoint
+	c.Password = config.Password
+	c.ProjectDomainID = config.ProjectDomainID
+	c.ProjectDomainName = config.ProjectDomainName
+	c.TenantID = accepter["tenant_id"].(string)
+	c.UserDomainID = config.UserDomainID
+	c.UserDomainName = config.UserDomainName
+	c.Username = config.Username
+	c.UserID = config.UserID
+
+	if v := accepter["auth_url"].(string); v != "" {
+		c.IdentityEndpoint = v
+	}
+
+	if v := accepter["user_name"].(string); v != "" {
+		c.Username = v
+		c.UserID = ""
+	}
+
+	if v := accepter["password"].(string); v != "" {
+		c.Password = v
+	}
+
+	return c, nil
+}
+
+func imageStoragesImageShareV2ListMembers(client *eclcloud.ServiceClient, imageID string) ([]members.Member, error) {
+	allPages, err := members.List(client, imageID).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	return members.ExtractMembers(allPages)
+}
@@ -0,0 +1,252 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedImageStoragesV2ImageShare_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystoneResponse := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystoneResponse)
+	mc.Register(t, "members", "/v2/images/3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c/members", testMockImageStoragesV2ImageShareListAccepted)
+	mc.Register(t, "members", "/v2/images/3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c/members", testMockImageStoragesV2ImageShareListUpdated)
+	mc.Register(t, "members", "/v2/images/3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c/members", testMockImageStoragesV2ImageShareListDeleted)
+	mc.Register(t, "members", "/v2/images/3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c/members", testMockImageStoragesV2ImageShareListEmpty)
+	mc.Register(t, "members", "/v2/images/3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c/members", testMockImageStoragesV2ImageShareCreate1)
+	mc.Register(t, "members", "/v2/images/3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c/members", testMockImageStoragesV2ImageShareCreate2)
+	mc.Register(t, "members", "/v2/images/3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c/members/a1b2c3d4e5f60718293a4b5c6d7e8f90", testMockImageStoragesV2ImageShareAccept)
+	mc.Register(t, "members", "/v2/images/3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c/members/a1b2c3d4e5f60718293a4b5c6d7e8f90", testMockImageStoragesV2ImageShareDelete1)
+	mc.Register(t, "members", "/v2/images/3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c/members/0f9e8d7c6b5a49382716f5e4d3c2b1a0", testMockImageStoragesV2ImageShareDelete2)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImageStoragesV2ImageShareDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockImageStoragesV2ImageShareBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_share_v2.share_1", "member_ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_share_v2.share_1", "members.0.member_id", "a1b2c3d4e5f60718293a4b5c6d7e8f90"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_share_v2.share_1", "members.0.status", "accepted"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_share_v2.share_1", "members.1.member_id", "0f9e8d7c6b5a49382716f5e4d3c2b1a0"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_share_v2.share_1", "members.1.status", "pending"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ecl_imagestorages_image_share_v2.share_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"accepter",
+				},
+			},
+			resource.TestStep{
+				Config: testMockImageStoragesV2ImageShareUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_share_v2.share_1", "member_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_share_v2.share_1", "members.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_share_v2.share_1", "members.0.status", "accepted"),
+				),
+			},
+		},
+	})
+}
+
+var testMockImageStoragesV2ImageShareBasic = `
+resource "ecl_imagestorages_image_share_v2" "share_1" {
+  image_id = "3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c"
+  member_ids = [
+    "a1b2c3d4e5f60718293a4b5c6d7e8f90",
+    "0f9e8d7c6b5a49382716f5e4d3c2b1a0",
+  ]
+
+  accepter {
+    tenant_id = "a1b2c3d4e5f60718293a4b5c6d7e8f90"
+  }
+}
+`
+
+var testMockImageStoragesV2ImageShareUpdate = `
+resource "ecl_imagestorages_image_share_v2" "share_1" {
+  image_id = "3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c"
+  member_ids = [
+    "a1b2c3d4e5f60718293a4b5c6d7e8f90",
+  ]
+
+  accepter {
+    tenant_id = "a1b2c3d4e5f60718293a4b5c6d7e8f90"
+  }
+}
+`
+
+var testMockImageStoragesV2ImageShareListEmpty = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "members": [],
+            "schema": "/v2/schemas/members"
+        }
+`
+
+var testMockImageStoragesV2ImageShareListAccepted = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "members": [
+                {
+                    "created_at": "2019-01-01T00:00:00Z",
+                    "image_id": "3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c",
+                    "member_id": "a1b2c3d4e5f60718293a4b5c6d7e8f90",
+                    "schema": "/v2/schemas/member",
+                    "status": "accepted",
+                    "updated_at": "2019-01-01T00:00:00Z"
+                },
+                {
+                    "created_at": "2019-01-01T00:00:00Z",
+                    "image_id": "3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c",
+                    "member_id": "0f9e8d7c6b5a49382716f5e4d3c2b1a0",
+                    "schema": "/v2/schemas/member",
+                    "status": "pending",
+                    "updated_at": "2019-01-01T00:00:00Z"
+                }
+            ],
+            "schema": "/v2/schemas/members"
+        }
+expectedStatus:
+    - Accepted
+`
+
+var testMockImageStoragesV2ImageShareListUpdated = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "members": [
+                {
+                    "created_at": "2019-01-01T00:00:00Z",
+                    "image_id": "3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c",
+                    "member_id": "a1b2c3d4e5f60718293a4b5c6d7e8f90",
+                    "schema": "/v2/schemas/member",
+                    "status": "accepted",
+                    "updated_at": "2019-01-01T00:00:00Z"
+                }
+            ],
+            "schema": "/v2/schemas/members"
+        }
+expectedStatus:
+    - Updated
+`
+
+var testMockImageStoragesV2ImageShareListDeleted = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "members": [],
+            "schema": "/v2/schemas/members"
+        }
+expectedStatus:
+    - Deleted
+`
+
+var testMockImageStoragesV2ImageShareCreate1 = `
+request:
+    method: POST
+    body: >
+        {"member":"a1b2c3d4e5f60718293a4b5c6d7e8f90"}
+response:
+    code: 200
+    body: >
+        {
+            "created_at": "2019-01-01T00:00:00Z",
+            "image_id": "3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c",
+            "member_id": "a1b2c3d4e5f60718293a4b5c6d7e8f90",
+            "schema": "/v2/schemas/member",
+            "status": "pending",
+            "updated_at": "2019-01-01T00:00:00Z"
+        }
+`
+
+var testMockImageStoragesV2ImageShareCreate2 = `
+request:
+    method: POST
+    body: >
+        {"member":"0f9e8d7c6b5a49382716f5e4d3c2b1a0"}
+response:
+    code: 200
+    body: >
+        {
+            "created_at": "2019-01-01T00:00:00Z",
+            "image_id": "3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c",
+            "member_id": "0f9e8d7c6b5a49382716f5e4d3c2b1a0",
+            "schema": "/v2/schemas/member",
+            "status": "pending",
+            "updated_at": "2019-01-01T00:00:00Z"
+        }
+`
+
+var testMockImageStoragesV2ImageShareAccept = `
+request:
+    method: PUT
+    body: >
+        {"status":"accepted"}
+response:
+    code: 200
+    body: >
+        {
+            "created_at": "2019-01-01T00:00:00Z",
+            "image_id": "3c5e9a7b-1d2f-4e6a-8b0c-9d1e2f3a4b5c",
+            "member_id": "a1b2c3d4e5f60718293a4b5c6d7e8f90",
+            "schema": "/v2/schemas/member",
+            "status": "accepted",
+            "updated_at": "2019-01-01T00:00:00Z"
+        }
+newStatus: Accepted
+`
+
+var testMockImageStoragesV2ImageShareDelete1 = `
+request:
+    method: DELETE
+response:
+    code: 204
+expectedStatus:
+    - Updated
+newStatus: Deleted
+`
+
+var testMockImageStoragesV2ImageShareDelete2 = `
+request:
+    method: DELETE
+response:
+    code: 204
+expectedStatus:
+    - Accepted
+newStatus: Updated
+`
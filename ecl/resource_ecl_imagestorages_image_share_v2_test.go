@@ -0,0 +1,92 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nttcom/eclcloud/v3"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccImageStoragesV2ImageShare_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckImageMemberAccepter(t)
+			createTemporalImage(localFileForDataSourceTest)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImageStoragesV2ImageShareDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImageStoragesV2ImageShareBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_share_v2.share_1", "member_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_share_v2.share_1", "members.0.member_id", OS_ACCEPTER_TENANT_ID),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_share_v2.share_1", "members.0.status", "accepted"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageStoragesV2ImageShareDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating ECL image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ecl_imagestorages_image_share_v2" {
+			continue
+		}
+
+		imageMembers, err := imageStoragesImageShareV2ListMembers(imageClient, rs.Primary.ID)
+		if err != nil {
+			if _, ok := err.(eclcloud.ErrDefault404); ok {
+				continue
+			}
+			return err
+		}
+
+		if len(imageMembers) > 0 {
+			return fmt.Errorf("Image %s is still shared with %d members", rs.Primary.ID, len(imageMembers))
+		}
+	}
+
+	return nil
+}
+
+var testAccImageStoragesV2ImageShareBasic = fmt.Sprintf(`
+resource "ecl_imagestorages_image_v2" "image_1" {
+	name   = "Temp Terraform AccTest"
+	local_file_path = "%s"
+	container_format = "bare"
+	disk_format = "qcow2"
+
+	timeouts {
+		create = "10m"
+	}
+}
+
+resource "ecl_imagestorages_image_share_v2" "share_1" {
+	image_id = "${ecl_imagestorages_image_v2.image_1.id}"
+	member_ids = ["%s"]
+
+	accepter {
+		tenant_id = "%s"
+	}
+}
+`,
+	localFileForResourceTest,
+	OS_ACCEPTER_TENANT_ID,
+	OS_ACCEPTER_TENANT_ID)
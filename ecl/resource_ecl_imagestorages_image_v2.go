@@ -1,12 +1,8 @@
 package ecl
 
 import (
-	"crypto/md5"
-	"encoding/hex"
 	"fmt"
-	"io"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -63,9 +59,41 @@ func resourceImageStoragesImageV2() *schema.Resource {
 			},
 
 			"local_file_path": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"image_source_url"},
+			},
+
+			"image_source_url": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateImageStoragesImageV2SourceURL,
+				ConflictsWith: []string{"local_file_path"},
+			},
+
+			"image_source_headers": &schema.Schema{
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Sensitive:        true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressImageStoragesImageV2SourceDiffs,
+			},
+
+			"image_source_checksum": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateImageStoragesImageV2SourceChecksum,
+				ConflictsWith: []string{"local_file_path"},
+			},
+
+			"image_cache_path": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"local_file_path"},
+				DiffSuppressFunc: suppressImageStoragesImageV2SourceDiffs,
 			},
 
 			"min_disk_gb": &schema.Schema{
@@ -198,6 +226,14 @@ func resourceImageStoragesImageV2Create(d *schema.ResourceData, meta interface{}
 		createOpts.Visibility = &visibility
 	}
 
+	// Open the image data before creating the image,
+	// so that an unreachable source does not leave an empty image.
+	src, err := resourceImageStoragesImageV2Source(d, config)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
 	d.Partial(true)
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -208,28 +244,12 @@ func resourceImageStoragesImageV2Create(d *schema.ResourceData, meta interface{}
 
 	d.SetId(newImg.ID)
 
-	// downloading/getting image file props
-	imgFilePath, err := resourceImageStoragesImageV2File(d)
-	if err != nil {
-		return fmt.Errorf("Error opening file for Image: %s", err)
-
-	}
-	fileSize, fileChecksum, err := resourceImageStoragesImageV2FileProps(imgFilePath)
-	if err != nil {
-		return fmt.Errorf("Error getting file props: %s", err)
-	}
-
 	// upload
-	imgFile, err := os.Open(imgFilePath)
-	if err != nil {
-		return fmt.Errorf("Error opening file %q: %s", imgFilePath, err)
-	}
-	defer imgFile.Close()
-	log.Printf("[WARN] Uploading image %s (%d bytes). This can be pretty long.", d.Id(), fileSize)
+	log.Printf("[WARN] Uploading image %s from %s (%d bytes). This can be pretty long.", d.Id(), src.name, src.size)
 
-	res := imagedata.Upload(imageClient, d.Id(), imgFile)
+	res := imagedata.Upload(imageClient, d.Id(), src)
 	if res.Err != nil {
-		return fmt.Errorf("Error while uploading file %q: %s", imgFilePath, res.Err)
+		return fmt.Errorf("Error while uploading %q: %s", src.name, res.Err)
 	}
 
 	//wait for active
@@ -252,7 +272,7 @@ func resourceImageStoragesImageV2Create(d *schema.ResourceData, meta interface{}
 	}
 
 	verifyChecksum := d.Get("verify_checksum").(bool)
-	if img.Checksum != fileChecksum && verifyChecksum {
+	if fileChecksum := src.Checksum(); img.Checksum != fileChecksum && verifyChecksum {
 		return fmt.Errorf("Error wrong checksum: got %q, expected %q", img.Checksum, fileChecksum)
 	}
 
@@ -512,49 +532,6 @@ func resourceImageStoragesImageV2VisibilityFromString(v string) images.ImageVisi
 	return ""
 }
 
-func fileMD5Checksum(f *os.File) (string, error) {
-	hash := md5.New()
-	if _, err := io.Copy(hash, f); err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(hash.Sum(nil)), nil
-}
-
-func resourceImageStoragesImageV2FileProps(filename string) (int64, string, error) {
-	var filesize int64
-	var filechecksum string
-
-	file, err := os.Open(filename)
-	if err != nil {
-		return -1, "", fmt.Errorf("Error opening file for Image: %s", err)
-
-	}
-	defer file.Close()
-
-	fstat, err := file.Stat()
-	if err != nil {
-		return -1, "", fmt.Errorf("Error reading image file %q: %s", file.Name(), err)
-	}
-
-	filesize = fstat.Size()
-	filechecksum, err = fileMD5Checksum(file)
-
-	if err != nil {
-		return -1, "", fmt.Errorf("Error computing image file %q checksum: %s", file.Name(), err)
-	}
-
-	return filesize, filechecksum, nil
-}
-
-func resourceImageStoragesImageV2File(d *schema.ResourceData) (string, error) {
-	if filename := d.Get("local_file_path").(string); filename != "" {
-		return filename, nil
-
-	} else {
-		return "", fmt.Errorf("Error in config. no file specified")
-	}
-}
-
 func resourceImageStoragesImageV2RefreshFunc(client *eclcloud.ServiceClient, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		img, err := images.Get(client, id).Extract()
@@ -591,6 +568,12 @@ func resourceImageStoragesImageV2ExpandProperties(v map[string]interface{}) map[
 }
 
 func resourceImageStoragesImageV2UpdateComputedAttributes(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" && diff.NewValueKnown("local_file_path") && diff.NewValueKnown("image_source_url") {
+		if diff.Get("local_file_path").(string) == "" && diff.Get("image_source_url").(string) == "" {
+			return fmt.Errorf("Either local_file_path or image_source_url must be specified")
+		}
+	}
+
 	if diff.HasChange("properties") {
 		// Only check if the image has been created.
 		if diff.Id() != "" {
@@ -630,3 +613,9 @@ func resourceImageStoragesImageV2UpdateComputedAttributes(diff *schema.ResourceD
 
 	return nil
 }
+
+// suppressImageStoragesImageV2SourceDiffs suppresses the changes of the
+// attributes only used to fetch the image data when the image is created.
+func suppressImageStoragesImageV2SourceDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
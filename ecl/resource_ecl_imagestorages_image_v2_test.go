@@ -1,12 +1,17 @@
 package ecl
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"testing"
 
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
 
 	"github.com/nttcom/eclcloud/v3/ecl/imagestorage/v2/images"
@@ -205,6 +210,48 @@ func TestAccImageStoragesV2Image_properties(t *testing.T) {
 	})
 }
 
+func TestAccImageStoragesV2Image_sourceURL(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	var image images.Image
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write([]byte("DummyContent\n"))
+	w.Close()
+	data := buf.Bytes()
+	checksum := sha256.Sum256(data)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImageStoragesV2ImageDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImageStoragesV2ImageSourceURL(server.URL+"/image.img.gz", hex.EncodeToString(checksum[:])),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageStoragesV2ImageExists("ecl_imagestorages_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_v2.image_1", "size_bytes", "13"),
+					resource.TestCheckResourceAttr(
+						"ecl_imagestorages_image_v2.image_1", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckImageStoragesV2ImageDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	imageClient, err := config.imageV2Client(OS_REGION_NAME)
@@ -504,3 +551,18 @@ var testAccImageStoragesV2ImageProperties4 = fmt.Sprintf(`
         bar = "foo"
       }
   }`, localFileForResourceTest)
+
+func testAccImageStoragesV2ImageSourceURL(sourceURL, checksum string) string {
+	return fmt.Sprintf(`
+  resource "ecl_imagestorages_image_v2" "image_1" {
+      name = "Temp Terraform AccTest"
+      image_source_url = "%s"
+      image_source_checksum = "sha256:%s"
+      container_format = "bare"
+      disk_format = "raw"
+
+      image_source_headers = {
+        Authorization = "Bearer token"
+      }
+  }`, sourceURL, checksum)
+}
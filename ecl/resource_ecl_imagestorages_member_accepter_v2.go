@@ -1,6 +1,7 @@
 package ecl
 
 import (
+	"fmt"
 	"log"
 	"time"
 
@@ -14,6 +15,9 @@ func resourceImageStoragesMemberAccepterV2() *schema.Resource {
 		Read:   resourceImageStoragesMemberV2Read,
 		Update: resourceImageStoragesMemberV2Update,
 		Delete: resourceImageStoragesMemberAccepterV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceImageStoragesMemberAccepterV2Import,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -56,3 +60,15 @@ func resourceImageStoragesMemberAccepterV2Delete(d *schema.ResourceData, meta in
 	log.Printf("[WARN] Will not delete Image Member. Terraform will remove this resource from the state file, however resources may remain.")
 	return nil
 }
+
+func resourceImageStoragesMemberAccepterV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	imageId, memberId, err := imageStoragesMemberV2ParseID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", imageId, memberId))
+	d.Set("image_member_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
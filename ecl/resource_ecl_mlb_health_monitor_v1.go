@@ -9,6 +9,9 @@ import (
 	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/health_monitors"
 )
 
+// mlbHealthMonitorV1StagedKeys are the keys of the staged configurations.
+var mlbHealthMonitorV1StagedKeys = []string{"port", "protocol", "interval", "retry", "timeout", "path", "http_status_code"}
+
 func resourceMLBHealthMonitorV1() *schema.Resource {
 	var result *schema.Resource
 
@@ -17,6 +20,9 @@ func resourceMLBHealthMonitorV1() *schema.Resource {
 		Read:   resourceMLBHealthMonitorV1Read,
 		Update: resourceMLBHealthMonitorV1Update,
 		Delete: resourceMLBHealthMonitorV1Delete,
+
+		CustomizeDiff: resourceMLBV1StagedChangesPending(mlbHealthMonitorV1StagedKeys...),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -71,9 +77,23 @@ func resourceMLBHealthMonitorV1() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"configuration_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staged_changes_pending": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema, mlbHealthMonitorV1StagedKeys...)
+
 	return result
 }
 
@@ -100,14 +120,24 @@ func resourceMLBHealthMonitorV1Create(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Creating ECL managed load balancer health monitor with options %+v", createOpts)
 
-	healthMonitor, err := health_monitors.Create(managedLoadBalancerClient, createOpts).Extract()
+	var healthMonitor *health_monitors.HealthMonitor
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		healthMonitor, err = health_monitors.Create(managedLoadBalancerClient, createOpts).Extract()
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating ECL managed load balancer health monitor with options %+v: %s", createOpts, err)
+		err = fmt.Errorf("Error creating ECL managed load balancer health monitor with options %+v: %s", createOpts, err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
 	}
 
 	d.SetId(healthMonitor.ID)
 	log.Printf("[INFO] ECL managed load balancer health monitor ID: %s", healthMonitor.ID)
 
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
+	if err != nil {
+		return err
+	}
+
 	return resourceMLBHealthMonitorV1Read(d, meta)
 }
 
@@ -166,11 +196,28 @@ func resourceMLBHealthMonitorV1Read(d *schema.ResourceData, meta interface{}) er
 		return nil
 	}
 
+	current := make([]interface{}, 0, 1)
+	if healthMonitor.ConfigurationStatus != "CREATE_STAGED" {
+		current = append(current, map[string]interface{}{
+			"port":             healthMonitor.Port,
+			"protocol":         healthMonitor.Protocol,
+			"interval":         healthMonitor.Interval,
+			"retry":            healthMonitor.Retry,
+			"timeout":          healthMonitor.Timeout,
+			"path":             healthMonitor.Path,
+			"http_status_code": healthMonitor.HttpStatusCode,
+		})
+	}
+
 	d.Set("name", healthMonitor.Name)
 	d.Set("description", healthMonitor.Description)
 	d.Set("tags", healthMonitor.Tags)
 	d.Set("load_balancer_id", healthMonitor.LoadBalancerID)
 	d.Set("tenant_id", healthMonitor.TenantID)
+	d.Set("configuration_status", healthMonitor.ConfigurationStatus)
+	d.Set("operation_status", healthMonitor.OperationStatus)
+	d.Set("staged_changes_pending", healthMonitor.ConfigurationStatus != "ACTIVE")
+	d.Set("current", current)
 
 	return nil
 }
@@ -182,18 +229,28 @@ func resourceMLBHealthMonitorV1Update(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating ECL managed load balancer client: %s", err)
 	}
 
-	log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer health monitor ...")
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer health monitor ...")
+
+		if err := resourceMLBHealthMonitorV1UpdateAttributes(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating attributes of ECL managed load balancer health monitor: %s", err)
+		}
+
+		log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer health monitor ...")
+
+		if err := resourceMLBHealthMonitorV1UpdateConfigurations(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating configurations of ECL managed load balancer health monitor: %s", err)
+		}
 
-	err = resourceMLBHealthMonitorV1UpdateAttributes(d, managedLoadBalancerClient)
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error in updating attributes of ECL managed load balancer health monitor: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
 	}
 
-	log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer health monitor ...")
-
-	err = resourceMLBHealthMonitorV1UpdateConfigurations(d, managedLoadBalancerClient)
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
 	if err != nil {
-		return fmt.Errorf("Error in updating configurations of ECL managed load balancer health monitor: %s", err)
+		return err
 	}
 
 	return resourceMLBHealthMonitorV1Read(d, meta)
@@ -354,7 +411,9 @@ func resourceMLBHealthMonitorV1Delete(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Deleting ECL managed load balancer health monitor: %s", d.Id())
 
-	err = health_monitors.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		return health_monitors.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	})
 	if err != nil {
 		if _, ok := err.(eclcloud.ErrDefault404); ok {
 			log.Printf("[DEBUG] Already deleted ECL managed load balancer health monitor (%s)", d.Id())
@@ -370,7 +429,13 @@ func resourceMLBHealthMonitorV1Delete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting ECL managed load balancer health monitor: %s", err)
+		err = fmt.Errorf("Error deleting ECL managed load balancer health monitor: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
+	}
+
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
+	if err != nil {
+		return err
 	}
 
 	return nil
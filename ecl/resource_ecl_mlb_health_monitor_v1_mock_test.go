@@ -3,6 +3,7 @@ package ecl
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 
@@ -52,6 +53,10 @@ func TestMockedAccMLBV1HealthMonitorResource(t *testing.T) {
 					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "http_status_code", "200-299"),
 					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "load_balancer_id", "67fea379-cff0-4191-9175-de7d6941a040"),
 					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "tenant_id", "34f5c98ef430457ba81292637d0c6fd0"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "configuration_status", "CREATE_STAGED"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "staged_changes_pending", "true"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "operation_status", "NONE"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "current.#", "0"),
 				),
 			},
 			{
@@ -70,6 +75,10 @@ func TestMockedAccMLBV1HealthMonitorResource(t *testing.T) {
 					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "http_status_code", ""),
 					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "load_balancer_id", "67fea379-cff0-4191-9175-de7d6941a040"),
 					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "tenant_id", "34f5c98ef430457ba81292637d0c6fd0"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "configuration_status", "CREATE_STAGED"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "staged_changes_pending", "true"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "operation_status", "NONE"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "current.#", "0"),
 				),
 			},
 			{
@@ -88,6 +97,52 @@ func TestMockedAccMLBV1HealthMonitorResource(t *testing.T) {
 					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "http_status_code", "200-299"),
 					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "load_balancer_id", "67fea379-cff0-4191-9175-de7d6941a040"),
 					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "tenant_id", "34f5c98ef430457ba81292637d0c6fd0"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "configuration_status", "UPDATE_STAGED"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "staged_changes_pending", "true"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "operation_status", "COMPLETE"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "current.#", "1"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "current.0.port", "0"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "current.0.protocol", "icmp"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "current.0.path", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestMockedAccMLBV1HealthMonitorResource_AutoApplyConfigurations(t *testing.T) {
+	defer func(delay time.Duration) { mlbAutoApplyConfigurationsDelay = delay }(mlbAutoApplyConfigurationsDelay)
+	mlbAutoApplyConfigurationsDelay = 1 * time.Second
+
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "load_balancers", "/v1.0/health_monitors", testMockMLBV1HealthMonitorsCreate)
+	// Staged configurations are applied after each change of the health monitor
+	mc.Register(t, "load_balancers", "/v1.0/load_balancers/67fea379-cff0-4191-9175-de7d6941a040", testMockMLBV1HealthMonitorsAutoApplyShowLoadBalancerBeforeApply)
+	mc.Register(t, "load_balancers", "/v1.0/health_monitors", testMockMLBV1HealthMonitorsAutoApplyListCreateStaged)
+	mc.Register(t, "load_balancers", "/v1.0/health_monitors", testMockMLBV1HealthMonitorsAutoApplyListDeleteStaged)
+	mc.Register(t, "load_balancers", "/v1.0/load_balancers/67fea379-cff0-4191-9175-de7d6941a040/action", testMockMLBV1HealthMonitorsAutoApplyAction)
+	mc.Register(t, "load_balancers", "/v1.0/load_balancers/67fea379-cff0-4191-9175-de7d6941a040", testMockMLBV1HealthMonitorsAutoApplyShowLoadBalancerAfterApply)
+	mc.Register(t, "load_balancers", "/v1.0/health_monitors/497f6eca-6276-4993-bfeb-53cbbbba6f08", testMockMLBV1HealthMonitorsAutoApplyShow)
+	mc.Register(t, "load_balancers", "/v1.0/health_monitors/497f6eca-6276-4993-bfeb-53cbbbba6f08", testMockMLBV1HealthMonitorsAutoApplyDelete)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMLBV1HealthMonitorAutoApplyConfigurations,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "id", "497f6eca-6276-4993-bfeb-53cbbbba6f08"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "port", "80"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "protocol", "http"),
+					resource.TestCheckResourceAttr("ecl_mlb_health_monitor_v1.health_monitor", "path", "/health"),
 				),
 			},
 		},
@@ -148,6 +203,14 @@ resource "ecl_mlb_health_monitor_v1" "health_monitor" {
 }
 `)
 
+var testAccMLBV1HealthMonitorAutoApplyConfigurations = fmt.Sprintf(`
+provider "ecl" {
+  mlb_auto_apply_configurations = true
+}
+
+%s
+`, testAccMLBV1HealthMonitor)
+
 var testMockMLBV1HealthMonitorsCreate = fmt.Sprintf(`
 request:
   method: POST
@@ -563,3 +626,167 @@ expectedStatus:
   - ConfigurationsUpdatedAfterApply
 newStatus: Deleted
 `)
+
+var testMockMLBV1HealthMonitorsAutoApplyShowLoadBalancerBeforeApply = fmt.Sprintf(`
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "load_balancer": {
+        "id": "67fea379-cff0-4191-9175-de7d6941a040",
+        "name": "load_balancer",
+        "description": "description",
+        "tags": {},
+        "configuration_status": "ACTIVE",
+        "monitoring_status": "ACTIVE",
+        "operation_status": "COMPLETE",
+        "revision": 1,
+        "plan_id": "00713021-9aea-41da-9a88-87760c08fa72",
+        "tenant_id": "34f5c98ef430457ba81292637d0c6fd0"
+      }
+    }
+expectedStatus:
+  - Created
+  - Deleted
+`)
+
+var testMockMLBV1HealthMonitorsAutoApplyShowLoadBalancerAfterApply = fmt.Sprintf(`
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "load_balancer": {
+        "id": "67fea379-cff0-4191-9175-de7d6941a040",
+        "name": "load_balancer",
+        "description": "description",
+        "tags": {},
+        "configuration_status": "ACTIVE",
+        "monitoring_status": "ACTIVE",
+        "operation_status": "COMPLETE",
+        "revision": 2,
+        "plan_id": "00713021-9aea-41da-9a88-87760c08fa72",
+        "tenant_id": "34f5c98ef430457ba81292637d0c6fd0"
+      }
+    }
+expectedStatus:
+  - Applied
+`)
+
+var testMockMLBV1HealthMonitorsAutoApplyListCreateStaged = fmt.Sprintf(`
+request:
+  method: GET
+  query:
+    load_balancer_id:
+      - 67fea379-cff0-4191-9175-de7d6941a040
+response:
+  code: 200
+  body: >
+    {
+      "health_monitors": [
+        {
+          "id": "497f6eca-6276-4993-bfeb-53cbbbba6f08",
+          "name": "health_monitor",
+          "configuration_status": "CREATE_STAGED",
+          "operation_status": "NONE",
+          "load_balancer_id": "67fea379-cff0-4191-9175-de7d6941a040"
+        }
+      ]
+    }
+expectedStatus:
+  - Created
+`)
+
+var testMockMLBV1HealthMonitorsAutoApplyListDeleteStaged = fmt.Sprintf(`
+request:
+  method: GET
+  query:
+    load_balancer_id:
+      - 67fea379-cff0-4191-9175-de7d6941a040
+response:
+  code: 200
+  body: >
+    {
+      "health_monitors": [
+        {
+          "id": "497f6eca-6276-4993-bfeb-53cbbbba6f08",
+          "name": "health_monitor",
+          "configuration_status": "DELETE_STAGED",
+          "operation_status": "COMPLETE",
+          "load_balancer_id": "67fea379-cff0-4191-9175-de7d6941a040"
+        }
+      ]
+    }
+expectedStatus:
+  - Deleted
+`)
+
+var testMockMLBV1HealthMonitorsAutoApplyAction = fmt.Sprintf(`
+request:
+  method: POST
+  body: >
+    {"apply-configurations":null}
+response:
+  code: 204
+expectedStatus:
+  - Created
+  - Deleted
+newStatus: Applied
+`)
+
+var testMockMLBV1HealthMonitorsAutoApplyShow = fmt.Sprintf(`
+request:
+  method: GET
+  query:
+    changes:
+      - true
+response:
+  code: 200
+  body: >
+    {
+      "health_monitor": {
+        "id": "497f6eca-6276-4993-bfeb-53cbbbba6f08",
+        "name": "health_monitor",
+        "description": "description",
+        "tags": {
+          "key": "value"
+        },
+        "configuration_status": "ACTIVE",
+        "operation_status": "COMPLETE",
+        "load_balancer_id": "67fea379-cff0-4191-9175-de7d6941a040",
+        "tenant_id": "34f5c98ef430457ba81292637d0c6fd0",
+        "port": 80,
+        "protocol": "http",
+        "interval": 5,
+        "retry": 3,
+        "timeout": 5,
+        "path": "/health",
+        "http_status_code": "200-299",
+        "current": {
+          "port": 80,
+          "protocol": "http",
+          "interval": 5,
+          "retry": 3,
+          "timeout": 5,
+          "path": "/health",
+          "http_status_code": "200-299"
+        },
+        "staged": null
+      }
+    }
+expectedStatus:
+  - Applied
+`)
+
+var testMockMLBV1HealthMonitorsAutoApplyDelete = fmt.Sprintf(`
+request:
+  method: DELETE
+response:
+  code: 204
+expectedStatus:
+  - Applied
+newStatus: Deleted
+`)
@@ -9,6 +9,9 @@ import (
 	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/listeners"
 )
 
+// mlbListenerV1StagedKeys are the keys of the staged configurations.
+var mlbListenerV1StagedKeys = []string{"ip_address", "port", "protocol"}
+
 func resourceMLBListenerV1() *schema.Resource {
 	var result *schema.Resource
 
@@ -17,6 +20,9 @@ func resourceMLBListenerV1() *schema.Resource {
 		Read:   resourceMLBListenerV1Read,
 		Update: resourceMLBListenerV1Update,
 		Delete: resourceMLBListenerV1Delete,
+
+		CustomizeDiff: resourceMLBV1StagedChangesPending(mlbListenerV1StagedKeys...),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -55,9 +61,23 @@ func resourceMLBListenerV1() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"configuration_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staged_changes_pending": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema, mlbListenerV1StagedKeys...)
+
 	return result
 }
 
@@ -80,14 +100,24 @@ func resourceMLBListenerV1Create(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Creating ECL managed load balancer listener with options %+v", createOpts)
 
-	listener, err := listeners.Create(managedLoadBalancerClient, createOpts).Extract()
+	var listener *listeners.Listener
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		listener, err = listeners.Create(managedLoadBalancerClient, createOpts).Extract()
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating ECL managed load balancer listener with options %+v: %s", createOpts, err)
+		err = fmt.Errorf("Error creating ECL managed load balancer listener with options %+v: %s", createOpts, err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
 	}
 
 	d.SetId(listener.ID)
 	log.Printf("[INFO] ECL managed load balancer listener ID: %s", listener.ID)
 
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
+	if err != nil {
+		return err
+	}
+
 	return resourceMLBListenerV1Read(d, meta)
 }
 
@@ -134,11 +164,24 @@ func resourceMLBListenerV1Read(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	current := make([]interface{}, 0, 1)
+	if listener.ConfigurationStatus != "CREATE_STAGED" {
+		current = append(current, map[string]interface{}{
+			"ip_address": listener.IPAddress,
+			"port":       listener.Port,
+			"protocol":   listener.Protocol,
+		})
+	}
+
 	d.Set("name", listener.Name)
 	d.Set("description", listener.Description)
 	d.Set("tags", listener.Tags)
 	d.Set("load_balancer_id", listener.LoadBalancerID)
 	d.Set("tenant_id", listener.TenantID)
+	d.Set("configuration_status", listener.ConfigurationStatus)
+	d.Set("operation_status", listener.OperationStatus)
+	d.Set("staged_changes_pending", listener.ConfigurationStatus != "ACTIVE")
+	d.Set("current", current)
 
 	return nil
 }
@@ -150,18 +193,28 @@ func resourceMLBListenerV1Update(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error creating ECL managed load balancer client: %s", err)
 	}
 
-	log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer listener ...")
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer listener ...")
+
+		if err := resourceMLBListenerV1UpdateAttributes(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating attributes of ECL managed load balancer listener: %s", err)
+		}
+
+		log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer listener ...")
+
+		if err := resourceMLBListenerV1UpdateConfigurations(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating configurations of ECL managed load balancer listener: %s", err)
+		}
 
-	err = resourceMLBListenerV1UpdateAttributes(d, managedLoadBalancerClient)
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error in updating attributes of ECL managed load balancer listener: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
 	}
 
-	log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer listener ...")
-
-	err = resourceMLBListenerV1UpdateConfigurations(d, managedLoadBalancerClient)
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
 	if err != nil {
-		return fmt.Errorf("Error in updating configurations of ECL managed load balancer listener: %s", err)
+		return err
 	}
 
 	return resourceMLBListenerV1Read(d, meta)
@@ -278,7 +331,9 @@ func resourceMLBListenerV1Delete(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Deleting ECL managed load balancer listener: %s", d.Id())
 
-	err = listeners.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		return listeners.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	})
 	if err != nil {
 		if _, ok := err.(eclcloud.ErrDefault404); ok {
 			log.Printf("[DEBUG] Already deleted ECL managed load balancer listener (%s)", d.Id())
@@ -294,7 +349,13 @@ func resourceMLBListenerV1Delete(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting ECL managed load balancer listener: %s", err)
+		err = fmt.Errorf("Error deleting ECL managed load balancer listener: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
+	}
+
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
+	if err != nil {
+		return err
 	}
 
 	return nil
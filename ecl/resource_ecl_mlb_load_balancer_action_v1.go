@@ -40,6 +40,10 @@ func resourceMLBLoadBalancerActionV1() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"cancel_configurations_on_failure": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"system_update": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -58,8 +62,8 @@ func resourceMLBLoadBalancerActionV1() *schema.Resource {
 	return result
 }
 
-func resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(d *schema.ResourceData, client *eclcloud.ServiceClient) (bool, error) {
-	loadBalancer, err := resourceMLBLoadBalancerActionV1ShowLoadBalancer(d, client)
+func resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(client *eclcloud.ServiceClient, loadBalancerID string) (bool, error) {
+	loadBalancer, err := resourceMLBLoadBalancerActionV1ShowLoadBalancer(client, loadBalancerID)
 	if err != nil {
 		return false, err
 	}
@@ -69,7 +73,7 @@ func resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(d *schema.R
 		return true, nil
 	}
 
-	healthMonitors, err := resourceMLBLoadBalancerActionV1ListHealthMonitors(d, client)
+	healthMonitors, err := resourceMLBLoadBalancerActionV1ListHealthMonitors(client, loadBalancerID)
 	if err != nil {
 		return false, err
 	}
@@ -81,7 +85,7 @@ func resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(d *schema.R
 		}
 	}
 
-	listeners, err := resourceMLBLoadBalancerActionV1ListListeners(d, client)
+	listeners, err := resourceMLBLoadBalancerActionV1ListListeners(client, loadBalancerID)
 	if err != nil {
 		return false, err
 	}
@@ -93,7 +97,7 @@ func resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(d *schema.R
 		}
 	}
 
-	policies, err := resourceMLBLoadBalancerActionV1ListPolicies(d, client)
+	policies, err := resourceMLBLoadBalancerActionV1ListPolicies(client, loadBalancerID)
 	if err != nil {
 		return false, err
 	}
@@ -105,7 +109,7 @@ func resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(d *schema.R
 		}
 	}
 
-	routes, err := resourceMLBLoadBalancerActionV1ListRoutes(d, client)
+	routes, err := resourceMLBLoadBalancerActionV1ListRoutes(client, loadBalancerID)
 	if err != nil {
 		return false, err
 	}
@@ -117,7 +121,7 @@ func resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(d *schema.R
 		}
 	}
 
-	rules, err := resourceMLBLoadBalancerActionV1ListRules(d, client)
+	rules, err := resourceMLBLoadBalancerActionV1ListRules(client, loadBalancerID)
 	if err != nil {
 		return false, err
 	}
@@ -129,7 +133,7 @@ func resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(d *schema.R
 		}
 	}
 
-	targetGroups, err := resourceMLBLoadBalancerActionV1ListTargetGroups(d, client)
+	targetGroups, err := resourceMLBLoadBalancerActionV1ListTargetGroups(client, loadBalancerID)
 	if err != nil {
 		return false, err
 	}
@@ -147,7 +151,7 @@ func resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(d *schema.R
 func resourceMLBLoadBalancerActionV1CheckSystemUpdateRequired(d *schema.ResourceData, client *eclcloud.ServiceClient) (bool, error) {
 	var systemUpdate system_updates.SystemUpdate
 
-	loadBalancer, err := resourceMLBLoadBalancerActionV1ShowLoadBalancer(d, client)
+	loadBalancer, err := resourceMLBLoadBalancerActionV1ShowLoadBalancer(client, d.Get("load_balancer_id").(string))
 	if err != nil {
 		return false, err
 	}
@@ -181,7 +185,7 @@ func resourceMLBLoadBalancerActionV1Perform(d *schema.ResourceData, meta interfa
 
 	actionOpts := load_balancers.ActionOpts{}
 	if d.Get("apply_configurations").(bool) {
-		isApplyConfigurationsRequired, err = resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(d, managedLoadBalancerClient)
+		isApplyConfigurationsRequired, err = resourceMLBLoadBalancerActionV1CheckApplyConfigurationsRequired(managedLoadBalancerClient, loadBalancerID)
 		if err != nil {
 			return err
 		}
@@ -207,7 +211,8 @@ func resourceMLBLoadBalancerActionV1Perform(d *schema.ResourceData, meta interfa
 
 		err = load_balancers.Action(managedLoadBalancerClient, loadBalancerID, actionOpts).ExtractErr()
 		if err != nil {
-			return fmt.Errorf("Error performing action on ECL managed load balancer load balancer (%s) with options %+v: %s", loadBalancerID, actionOpts, err)
+			err = fmt.Errorf("Error performing action on ECL managed load balancer load balancer (%s) with options %+v: %s", loadBalancerID, actionOpts, err)
+			return resourceMLBLoadBalancerActionV1Failed(d, managedLoadBalancerClient, actionOpts, err)
 		}
 
 		stateChangeConf := &resource.StateChangeConf{
@@ -222,7 +227,8 @@ func resourceMLBLoadBalancerActionV1Perform(d *schema.ResourceData, meta interfa
 
 		_, err = stateChangeConf.WaitForState()
 		if err != nil {
-			return fmt.Errorf("Error waiting for ECL managed load balancer load balancer (%s) to become COMPLETE: %s", loadBalancerID, err)
+			err = fmt.Errorf("Error waiting for ECL managed load balancer load balancer (%s) to become COMPLETE: %s", loadBalancerID, err)
+			return resourceMLBLoadBalancerActionV1Failed(d, managedLoadBalancerClient, actionOpts, err)
 		}
 	} else {
 		log.Printf("[DEBUG] No action required on ECL managed load balancer load balancer (%s)", loadBalancerID)
@@ -233,6 +239,17 @@ func resourceMLBLoadBalancerActionV1Perform(d *schema.ResourceData, meta interfa
 	return resourceMLBLoadBalancerActionV1Read(d, meta)
 }
 
+// resourceMLBLoadBalancerActionV1Failed cancels the staged configurations of
+// the load balancer after a failed apply when cancel_configurations_on_failure
+// is set, and returns err with the objects which were rolled back.
+func resourceMLBLoadBalancerActionV1Failed(d *schema.ResourceData, client *eclcloud.ServiceClient, actionOpts load_balancers.ActionOpts, err error) error {
+	if !actionOpts.ApplyConfigurations || !d.Get("cancel_configurations_on_failure").(bool) {
+		return err
+	}
+
+	return mlbLoadBalancerV1CancelConfigurations(client, d.Get("load_balancer_id").(string), err)
+}
+
 func resourceMLBLoadBalancerActionV1WaitForComplete(client *eclcloud.ServiceClient, loadBalancerID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		loadBalancer, err := load_balancers.Show(client, loadBalancerID, load_balancers.ShowOpts{}).Extract()
@@ -244,10 +261,9 @@ func resourceMLBLoadBalancerActionV1WaitForComplete(client *eclcloud.ServiceClie
 	}
 }
 
-func resourceMLBLoadBalancerActionV1ShowLoadBalancer(d *schema.ResourceData, client *eclcloud.ServiceClient) (*load_balancers.LoadBalancer, error) {
+func resourceMLBLoadBalancerActionV1ShowLoadBalancer(client *eclcloud.ServiceClient, loadBalancerID string) (*load_balancers.LoadBalancer, error) {
 	var loadBalancer load_balancers.LoadBalancer
 
-	loadBalancerID := d.Get("load_balancer_id").(string)
 	err := load_balancers.Show(client, loadBalancerID, load_balancers.ShowOpts{}).ExtractInto(&loadBalancer)
 	if err != nil {
 		return nil, fmt.Errorf("Unable to retrieve ECL managed load balancer load balancer (%s): %s", loadBalancerID, err)
@@ -256,8 +272,8 @@ func resourceMLBLoadBalancerActionV1ShowLoadBalancer(d *schema.ResourceData, cli
 	return &loadBalancer, nil
 }
 
-func resourceMLBLoadBalancerActionV1ListHealthMonitors(d *schema.ResourceData, client *eclcloud.ServiceClient) (*[]health_monitors.HealthMonitor, error) {
-	listOpts := health_monitors.ListOpts{LoadBalancerID: d.Get("load_balancer_id").(string)}
+func resourceMLBLoadBalancerActionV1ListHealthMonitors(client *eclcloud.ServiceClient, loadBalancerID string) (*[]health_monitors.HealthMonitor, error) {
+	listOpts := health_monitors.ListOpts{LoadBalancerID: loadBalancerID}
 	pages, err := health_monitors.List(client, listOpts).AllPages()
 	if err != nil {
 		return nil, err
@@ -271,8 +287,8 @@ func resourceMLBLoadBalancerActionV1ListHealthMonitors(d *schema.ResourceData, c
 	return &healthMonitors, nil
 }
 
-func resourceMLBLoadBalancerActionV1ListListeners(d *schema.ResourceData, client *eclcloud.ServiceClient) (*[]listeners.Listener, error) {
-	listOpts := listeners.ListOpts{LoadBalancerID: d.Get("load_balancer_id").(string)}
+func resourceMLBLoadBalancerActionV1ListListeners(client *eclcloud.ServiceClient, loadBalancerID string) (*[]listeners.Listener, error) {
+	listOpts := listeners.ListOpts{LoadBalancerID: loadBalancerID}
 	pages, err := listeners.List(client, listOpts).AllPages()
 	if err != nil {
 		return nil, err
@@ -286,8 +302,8 @@ func resourceMLBLoadBalancerActionV1ListListeners(d *schema.ResourceData, client
 	return &listeners, nil
 }
 
-func resourceMLBLoadBalancerActionV1ListPolicies(d *schema.ResourceData, client *eclcloud.ServiceClient) (*[]policies.Policy, error) {
-	listOpts := policies.ListOpts{LoadBalancerID: d.Get("load_balancer_id").(string)}
+func resourceMLBLoadBalancerActionV1ListPolicies(client *eclcloud.ServiceClient, loadBalancerID string) (*[]policies.Policy, error) {
+	listOpts := policies.ListOpts{LoadBalancerID: loadBalancerID}
 	pages, err := policies.List(client, listOpts).AllPages()
 	if err != nil {
 		return nil, err
@@ -301,8 +317,8 @@ func resourceMLBLoadBalancerActionV1ListPolicies(d *schema.ResourceData, client
 	return &policies, nil
 }
 
-func resourceMLBLoadBalancerActionV1ListRoutes(d *schema.ResourceData, client *eclcloud.ServiceClient) (*[]routes.Route, error) {
-	listOpts := routes.ListOpts{LoadBalancerID: d.Get("load_balancer_id").(string)}
+func resourceMLBLoadBalancerActionV1ListRoutes(client *eclcloud.ServiceClient, loadBalancerID string) (*[]routes.Route, error) {
+	listOpts := routes.ListOpts{LoadBalancerID: loadBalancerID}
 	pages, err := routes.List(client, listOpts).AllPages()
 	if err != nil {
 		return nil, err
@@ -316,8 +332,8 @@ func resourceMLBLoadBalancerActionV1ListRoutes(d *schema.ResourceData, client *e
 	return &routes, nil
 }
 
-func resourceMLBLoadBalancerActionV1ListRules(d *schema.ResourceData, client *eclcloud.ServiceClient) (*[]rules.Rule, error) {
-	listOpts := rules.ListOpts{LoadBalancerID: d.Get("load_balancer_id").(string)}
+func resourceMLBLoadBalancerActionV1ListRules(client *eclcloud.ServiceClient, loadBalancerID string) (*[]rules.Rule, error) {
+	listOpts := rules.ListOpts{LoadBalancerID: loadBalancerID}
 	pages, err := rules.List(client, listOpts).AllPages()
 	if err != nil {
 		return nil, err
@@ -331,8 +347,8 @@ func resourceMLBLoadBalancerActionV1ListRules(d *schema.ResourceData, client *ec
 	return &rules, nil
 }
 
-func resourceMLBLoadBalancerActionV1ListTargetGroups(d *schema.ResourceData, client *eclcloud.ServiceClient) (*[]target_groups.TargetGroup, error) {
-	listOpts := target_groups.ListOpts{LoadBalancerID: d.Get("load_balancer_id").(string)}
+func resourceMLBLoadBalancerActionV1ListTargetGroups(client *eclcloud.ServiceClient, loadBalancerID string) (*[]target_groups.TargetGroup, error) {
+	listOpts := target_groups.ListOpts{LoadBalancerID: loadBalancerID}
 	pages, err := target_groups.List(client, listOpts).AllPages()
 	if err != nil {
 		return nil, err
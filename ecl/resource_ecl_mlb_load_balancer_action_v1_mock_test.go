@@ -2,6 +2,7 @@ package ecl
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -167,6 +168,38 @@ func TestMockedAccMLBV1LoadBalancerActionResource_NoSystemUpdate(t *testing.T) {
 	})
 }
 
+func TestMockedAccMLBV1LoadBalancerActionResource_CancelConfigurationsOnFailure(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "load_balancers", "/v1.0/load_balancers/497f6eca-6276-4993-bfeb-53cbbbba6f08", testMockMLBV1LoadBalancersShowBeforeActionCreateStaged)
+	mc.Register(t, "load_balancers", "/v1.0/load_balancers/497f6eca-6276-4993-bfeb-53cbbbba6f08/action", testMockMLBV1LoadBalancersActionApplyConfigurations)
+	mc.Register(t, "load_balancers", "/v1.0/load_balancers/497f6eca-6276-4993-bfeb-53cbbbba6f08", testMockMLBV1LoadBalancersShowAfterActionError)
+	mc.Register(t, "load_balancers", "/v1.0/load_balancers/497f6eca-6276-4993-bfeb-53cbbbba6f08/action", testMockMLBV1LoadBalancersActionCancelConfigurations)
+	mc.Register(t, "health_monitors", "/v1.0/health_monitors", testMockMLBV1HealthMonitorsListUpdateStaged)
+	mc.Register(t, "listeners", "/v1.0/listeners", testMockMLBV1ListenersListEmpty)
+	mc.Register(t, "policies", "/v1.0/policies", testMockMLBV1PoliciesListEmpty)
+	mc.Register(t, "routes", "/v1.0/routes", testMockMLBV1RoutesListEmpty)
+	mc.Register(t, "rules", "/v1.0/rules", testMockMLBV1RulesListEmpty)
+	mc.Register(t, "target_groups", "/v1.0/target_groups", testMockMLBV1TargetGroupsListEmpty)
+
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMLBV1LoadBalancerActionCancelConfigurationsOnFailure,
+				ExpectError: regexp.MustCompile(`rolled back:\s+health monitor 4e9cc6c7-2bb5-4a1b-b8b0-3a8ad3a2dfb6 \(UPDATE_STAGED\)\s+load balancer 497f6eca-6276-4993-bfeb-53cbbbba6f08 \(CREATE_STAGED\)`),
+			},
+		},
+	})
+}
+
 var testAccMLBV1LoadBalancerActionApplyConfigurations = fmt.Sprintf(`
 resource "ecl_mlb_load_balancer_action_v1" "load_balancer_action" {
   load_balancer_id = "497f6eca-6276-4993-bfeb-53cbbbba6f08"
@@ -193,6 +226,14 @@ resource "ecl_mlb_load_balancer_action_v1" "load_balancer_action" {
 }
 `)
 
+var testAccMLBV1LoadBalancerActionCancelConfigurationsOnFailure = fmt.Sprintf(`
+resource "ecl_mlb_load_balancer_action_v1" "load_balancer_action" {
+  load_balancer_id = "497f6eca-6276-4993-bfeb-53cbbbba6f08"
+  apply_configurations = true
+  cancel_configurations_on_failure = true
+}
+`)
+
 var testMockMLBV1LoadBalancersShowBeforeActionCreateStaged = fmt.Sprintf(`
 request:
   method: GET
@@ -375,6 +416,38 @@ counter:
   min: 4
 `)
 
+var testMockMLBV1LoadBalancersShowAfterActionError = fmt.Sprintf(`
+request:
+  method: GET
+response:
+  code: 200
+  body: >
+    {
+      "load_balancer": {
+        "id": "497f6eca-6276-4993-bfeb-53cbbbba6f08",
+        "name": "load_balancer",
+        "description": "description",
+        "tags": {
+          "key": "value"
+        },
+        "configuration_status": "CREATE_STAGED",
+        "monitoring_status": "INITIAL",
+        "operation_status": "ERROR",
+        "primary_availability_zone": null,
+        "secondary_availability_zone": null,
+        "active_availability_zone": "UNDEFINED",
+        "revision": 1,
+        "plan_id": "00713021-9aea-41da-9a88-87760c08fa72",
+        "plan_name": "50M_HA_4IF",
+        "tenant_id": "34f5c98ef430457ba81292637d0c6fd0",
+        "syslog_servers": null,
+        "interfaces": null
+      }
+    }
+expectedStatus:
+  - Performed
+`)
+
 var testMockMLBV1LoadBalancersActionApplyConfigurations = fmt.Sprintf(`
 request:
   method: POST
@@ -385,6 +458,18 @@ response:
 newStatus: Performed
 `)
 
+var testMockMLBV1LoadBalancersActionCancelConfigurations = fmt.Sprintf(`
+request:
+  method: POST
+  body: >
+    {"cancel-configurations":null}
+response:
+  code: 204
+expectedStatus:
+  - Performed
+newStatus: Cancelled
+`)
+
 var testMockMLBV1LoadBalancersActionSystemUpdate = fmt.Sprintf(`
 request:
   method: POST
@@ -440,6 +525,28 @@ response:
     }
 `)
 
+var testMockMLBV1HealthMonitorsListUpdateStaged = fmt.Sprintf(`
+request:
+  method: GET
+  query:
+    load_balancer_id:
+      - 497f6eca-6276-4993-bfeb-53cbbbba6f08
+response:
+  code: 200
+  body: >
+    {
+      "health_monitors": [
+        {
+          "id": "4e9cc6c7-2bb5-4a1b-b8b0-3a8ad3a2dfb6",
+          "name": "health_monitor",
+          "configuration_status": "UPDATE_STAGED",
+          "operation_status": "COMPLETE",
+          "load_balancer_id": "497f6eca-6276-4993-bfeb-53cbbbba6f08"
+        }
+      ]
+    }
+`)
+
 var testMockMLBV1ListenersListEmpty = fmt.Sprintf(`
 request:
   method: GET
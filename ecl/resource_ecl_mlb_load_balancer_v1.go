@@ -75,6 +75,9 @@ func syslogServersSchemaForResource() *schema.Schema {
 	}
 }
 
+// mlbLoadBalancerV1StagedKeys are the keys of the staged configurations.
+var mlbLoadBalancerV1StagedKeys = []string{"syslog_servers", "interfaces"}
+
 func resourceMLBLoadBalancerV1() *schema.Resource {
 	var result *schema.Resource
 
@@ -83,6 +86,9 @@ func resourceMLBLoadBalancerV1() *schema.Resource {
 		Read:   resourceMLBLoadBalancerV1Read,
 		Update: resourceMLBLoadBalancerV1Update,
 		Delete: resourceMLBLoadBalancerV1Delete,
+
+		CustomizeDiff: resourceMLBV1StagedChangesPending(mlbLoadBalancerV1StagedKeys...),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -115,9 +121,23 @@ func resourceMLBLoadBalancerV1() *schema.Resource {
 			},
 			"syslog_servers": syslogServersSchemaForResource(),
 			"interfaces":     interfacesSchemaForResource(),
+			"configuration_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staged_changes_pending": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema, mlbLoadBalancerV1StagedKeys...)
+
 	return result
 }
 
@@ -172,6 +192,11 @@ func resourceMLBLoadBalancerV1Create(d *schema.ResourceData, meta interface{}) e
 	d.SetId(loadBalancer.ID)
 	log.Printf("[INFO] ECL managed load balancer load balancer ID: %s", loadBalancer.ID)
 
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Id())
+	if err != nil {
+		return err
+	}
+
 	return resourceMLBLoadBalancerV1Read(d, meta)
 }
 
@@ -271,11 +296,48 @@ func resourceMLBLoadBalancerV1Read(d *schema.ResourceData, meta interface{}) err
 		d.Set("interfaces", interfaces)
 	}
 
+	current := make([]interface{}, 0, 1)
+	if loadBalancer.ConfigurationStatus != "CREATE_STAGED" {
+		currentSyslogServers := make([]interface{}, len(loadBalancer.SyslogServers))
+		for i, syslogServer := range loadBalancer.SyslogServers {
+			currentSyslogServers[i] = map[string]interface{}{
+				"ip_address": syslogServer.IPAddress,
+				"port":       syslogServer.Port,
+				"protocol":   syslogServer.Protocol,
+			}
+		}
+
+		currentInterfaces := make([]interface{}, len(loadBalancer.Interfaces))
+		for i, interfaceV := range loadBalancer.Interfaces {
+			reservedFixedIPs := make([]interface{}, len(interfaceV.ReservedFixedIPs))
+			for j, reservedFixedIP := range interfaceV.ReservedFixedIPs {
+				reservedFixedIPs[j] = map[string]interface{}{
+					"ip_address": reservedFixedIP.IPAddress,
+				}
+			}
+
+			currentInterfaces[i] = map[string]interface{}{
+				"network_id":         interfaceV.NetworkID,
+				"virtual_ip_address": interfaceV.VirtualIPAddress,
+				"reserved_fixed_ips": reservedFixedIPs,
+			}
+		}
+
+		current = append(current, map[string]interface{}{
+			"syslog_servers": currentSyslogServers,
+			"interfaces":     currentInterfaces,
+		})
+	}
+
 	d.Set("name", loadBalancer.Name)
 	d.Set("description", loadBalancer.Description)
 	d.Set("tags", loadBalancer.Tags)
 	d.Set("plan_id", loadBalancer.PlanID)
 	d.Set("tenant_id", loadBalancer.TenantID)
+	d.Set("configuration_status", loadBalancer.ConfigurationStatus)
+	d.Set("operation_status", loadBalancer.OperationStatus)
+	d.Set("staged_changes_pending", loadBalancer.ConfigurationStatus != "ACTIVE")
+	d.Set("current", current)
 
 	return nil
 }
@@ -287,18 +349,28 @@ func resourceMLBLoadBalancerV1Update(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating ECL managed load balancer client: %s", err)
 	}
 
-	log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer load balancer ...")
+	err = config.mlbStageConfigurations(d.Id(), func() error {
+		log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer load balancer ...")
+
+		if err := resourceMLBLoadBalancerV1UpdateAttributes(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating attributes of ECL managed load balancer load balancer: %s", err)
+		}
+
+		log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer load balancer ...")
+
+		if err := resourceMLBLoadBalancerV1UpdateConfigurations(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating configurations of ECL managed load balancer load balancer: %s", err)
+		}
 
-	err = resourceMLBLoadBalancerV1UpdateAttributes(d, managedLoadBalancerClient)
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error in updating attributes of ECL managed load balancer load balancer: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Id(), err)
 	}
 
-	log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer load balancer ...")
-
-	err = resourceMLBLoadBalancerV1UpdateConfigurations(d, managedLoadBalancerClient)
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Id())
 	if err != nil {
-		return fmt.Errorf("Error in updating configurations of ECL managed load balancer load balancer: %s", err)
+		return err
 	}
 
 	return resourceMLBLoadBalancerV1Read(d, meta)
@@ -470,7 +542,9 @@ func resourceMLBLoadBalancerV1Delete(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[DEBUG] Deleting ECL managed load balancer load balancer: %s", d.Id())
 
-	err = load_balancers.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	err = config.mlbStageConfigurations(d.Id(), func() error {
+		return load_balancers.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	})
 	if err != nil {
 		if _, ok := err.(eclcloud.ErrDefault404); ok {
 			log.Printf("[DEBUG] Already deleted ECL managed load balancer load balancer (%s)", d.Id())
@@ -36,6 +36,9 @@ func serverNameIndicationsSchemaForResource() *schema.Schema {
 	}
 }
 
+// mlbPolicyV1StagedKeys are the keys of the staged configurations.
+var mlbPolicyV1StagedKeys = []string{"algorithm", "persistence", "persistence_timeout", "idle_timeout", "sorry_page_url", "source_nat", "server_name_indications", "certificate_id", "health_monitor_id", "listener_id", "default_target_group_id", "backup_target_group_id", "tls_policy_id"}
+
 func resourceMLBPolicyV1() *schema.Resource {
 	var result *schema.Resource
 
@@ -44,6 +47,9 @@ func resourceMLBPolicyV1() *schema.Resource {
 		Read:   resourceMLBPolicyV1Read,
 		Update: resourceMLBPolicyV1Update,
 		Delete: resourceMLBPolicyV1Delete,
+
+		CustomizeDiff: resourceMLBV1StagedChangesPending(mlbPolicyV1StagedKeys...),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -119,9 +125,23 @@ func resourceMLBPolicyV1() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"configuration_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staged_changes_pending": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema, mlbPolicyV1StagedKeys...)
+
 	return result
 }
 
@@ -164,14 +184,24 @@ func resourceMLBPolicyV1Create(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Creating ECL managed load balancer policy with options %+v", createOpts)
 
-	policy, err := policies.Create(managedLoadBalancerClient, createOpts).Extract()
+	var policy *policies.Policy
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		policy, err = policies.Create(managedLoadBalancerClient, createOpts).Extract()
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating ECL managed load balancer policy with options %+v: %s", createOpts, err)
+		err = fmt.Errorf("Error creating ECL managed load balancer policy with options %+v: %s", createOpts, err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
 	}
 
 	d.SetId(policy.ID)
 	log.Printf("[INFO] ECL managed load balancer policy ID: %s", policy.ID)
 
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
+	if err != nil {
+		return err
+	}
+
 	return resourceMLBPolicyV1Read(d, meta)
 }
 
@@ -283,11 +313,44 @@ func resourceMLBPolicyV1Read(d *schema.ResourceData, meta interface{}) error {
 		d.Set("server_name_indications", serverNameIndications)
 	}
 
+	current := make([]interface{}, 0, 1)
+	if policy.ConfigurationStatus != "CREATE_STAGED" {
+		currentServerNameIndications := make([]interface{}, len(policy.ServerNameIndications))
+		for i, serverNameIndication := range policy.ServerNameIndications {
+			currentServerNameIndications[i] = map[string]interface{}{
+				"server_name":    serverNameIndication.ServerName,
+				"input_type":     serverNameIndication.InputType,
+				"priority":       serverNameIndication.Priority,
+				"certificate_id": serverNameIndication.CertificateID,
+			}
+		}
+
+		current = append(current, map[string]interface{}{
+			"algorithm":               policy.Algorithm,
+			"persistence":             policy.Persistence,
+			"persistence_timeout":     policy.PersistenceTimeout,
+			"idle_timeout":            policy.IdleTimeout,
+			"sorry_page_url":          policy.SorryPageUrl,
+			"source_nat":              policy.SourceNat,
+			"server_name_indications": currentServerNameIndications,
+			"certificate_id":          policy.CertificateID,
+			"health_monitor_id":       policy.HealthMonitorID,
+			"listener_id":             policy.ListenerID,
+			"default_target_group_id": policy.DefaultTargetGroupID,
+			"backup_target_group_id":  policy.BackupTargetGroupID,
+			"tls_policy_id":           policy.TLSPolicyID,
+		})
+	}
+
 	d.Set("name", policy.Name)
 	d.Set("description", policy.Description)
 	d.Set("tags", policy.Tags)
 	d.Set("load_balancer_id", policy.LoadBalancerID)
 	d.Set("tenant_id", policy.TenantID)
+	d.Set("configuration_status", policy.ConfigurationStatus)
+	d.Set("operation_status", policy.OperationStatus)
+	d.Set("staged_changes_pending", policy.ConfigurationStatus != "ACTIVE")
+	d.Set("current", current)
 
 	return nil
 }
@@ -299,18 +362,28 @@ func resourceMLBPolicyV1Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating ECL managed load balancer client: %s", err)
 	}
 
-	log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer policy ...")
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer policy ...")
+
+		if err := resourceMLBPolicyV1UpdateAttributes(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating attributes of ECL managed load balancer policy: %s", err)
+		}
+
+		log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer policy ...")
 
-	err = resourceMLBPolicyV1UpdateAttributes(d, managedLoadBalancerClient)
+		if err := resourceMLBPolicyV1UpdateConfigurations(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating configurations of ECL managed load balancer policy: %s", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error in updating attributes of ECL managed load balancer policy: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
 	}
 
-	log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer policy ...")
-
-	err = resourceMLBPolicyV1UpdateConfigurations(d, managedLoadBalancerClient)
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
 	if err != nil {
-		return fmt.Errorf("Error in updating configurations of ECL managed load balancer policy: %s", err)
+		return err
 	}
 
 	return resourceMLBPolicyV1Read(d, meta)
@@ -560,7 +633,9 @@ func resourceMLBPolicyV1Delete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Deleting ECL managed load balancer policy: %s", d.Id())
 
-	err = policies.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		return policies.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	})
 	if err != nil {
 		if _, ok := err.(eclcloud.ErrDefault404); ok {
 			log.Printf("[DEBUG] Already deleted ECL managed load balancer policy (%s)", d.Id())
@@ -576,7 +651,13 @@ func resourceMLBPolicyV1Delete(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting ECL managed load balancer policy: %s", err)
+		err = fmt.Errorf("Error deleting ECL managed load balancer policy: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
+	}
+
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
+	if err != nil {
+		return err
 	}
 
 	return nil
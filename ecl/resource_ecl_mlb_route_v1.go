@@ -9,6 +9,9 @@ import (
 	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/routes"
 )
 
+// mlbRouteV1StagedKeys are the keys of the staged configurations.
+var mlbRouteV1StagedKeys = []string{"next_hop_ip_address"}
+
 func resourceMLBRouteV1() *schema.Resource {
 	var result *schema.Resource
 
@@ -17,6 +20,9 @@ func resourceMLBRouteV1() *schema.Resource {
 		Read:   resourceMLBRouteV1Read,
 		Update: resourceMLBRouteV1Update,
 		Delete: resourceMLBRouteV1Delete,
+
+		CustomizeDiff: resourceMLBV1StagedChangesPending(mlbRouteV1StagedKeys...),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -52,9 +58,23 @@ func resourceMLBRouteV1() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"configuration_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staged_changes_pending": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema, mlbRouteV1StagedKeys...)
+
 	return result
 }
 
@@ -76,14 +96,24 @@ func resourceMLBRouteV1Create(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Creating ECL managed load balancer route with options %+v", createOpts)
 
-	route, err := routes.Create(managedLoadBalancerClient, createOpts).Extract()
+	var route *routes.Route
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		route, err = routes.Create(managedLoadBalancerClient, createOpts).Extract()
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating ECL managed load balancer route with options %+v: %s", createOpts, err)
+		err = fmt.Errorf("Error creating ECL managed load balancer route with options %+v: %s", createOpts, err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
 	}
 
 	d.SetId(route.ID)
 	log.Printf("[INFO] ECL managed load balancer route ID: %s", route.ID)
 
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
+	if err != nil {
+		return err
+	}
+
 	return resourceMLBRouteV1Read(d, meta)
 }
 
@@ -122,12 +152,23 @@ func resourceMLBRouteV1Read(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	current := make([]interface{}, 0, 1)
+	if route.ConfigurationStatus != "CREATE_STAGED" {
+		current = append(current, map[string]interface{}{
+			"next_hop_ip_address": route.NextHopIPAddress,
+		})
+	}
+
 	d.Set("name", route.Name)
 	d.Set("description", route.Description)
 	d.Set("tags", route.Tags)
 	d.Set("destination_cidr", route.DestinationCidr)
 	d.Set("load_balancer_id", route.LoadBalancerID)
 	d.Set("tenant_id", route.TenantID)
+	d.Set("configuration_status", route.ConfigurationStatus)
+	d.Set("operation_status", route.OperationStatus)
+	d.Set("staged_changes_pending", route.ConfigurationStatus != "ACTIVE")
+	d.Set("current", current)
 
 	return nil
 }
@@ -139,18 +180,28 @@ func resourceMLBRouteV1Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating ECL managed load balancer client: %s", err)
 	}
 
-	log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer route ...")
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer route ...")
+
+		if err := resourceMLBRouteV1UpdateAttributes(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating attributes of ECL managed load balancer route: %s", err)
+		}
+
+		log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer route ...")
+
+		if err := resourceMLBRouteV1UpdateConfigurations(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating configurations of ECL managed load balancer route: %s", err)
+		}
 
-	err = resourceMLBRouteV1UpdateAttributes(d, managedLoadBalancerClient)
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error in updating attributes of ECL managed load balancer route: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
 	}
 
-	log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer route ...")
-
-	err = resourceMLBRouteV1UpdateConfigurations(d, managedLoadBalancerClient)
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
 	if err != nil {
-		return fmt.Errorf("Error in updating configurations of ECL managed load balancer route: %s", err)
+		return err
 	}
 
 	return resourceMLBRouteV1Read(d, meta)
@@ -245,7 +296,9 @@ func resourceMLBRouteV1Delete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Deleting ECL managed load balancer route: %s", d.Id())
 
-	err = routes.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		return routes.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	})
 	if err != nil {
 		if _, ok := err.(eclcloud.ErrDefault404); ok {
 			log.Printf("[DEBUG] Already deleted ECL managed load balancer route (%s)", d.Id())
@@ -261,7 +314,13 @@ func resourceMLBRouteV1Delete(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting ECL managed load balancer route: %s", err)
+		err = fmt.Errorf("Error deleting ECL managed load balancer route: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
+	}
+
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
+	if err != nil {
+		return err
 	}
 
 	return nil
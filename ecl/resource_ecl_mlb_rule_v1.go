@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/policies"
 	"github.com/nttcom/eclcloud/v3/ecl/managed_load_balancer/v1/rules"
 )
 
@@ -29,6 +30,9 @@ func conditionsSchemaForResource() *schema.Schema {
 	}
 }
 
+// mlbRuleV1StagedKeys are the keys of the staged configurations.
+var mlbRuleV1StagedKeys = []string{"priority", "target_group_id", "backup_target_group_id", "conditions"}
+
 func resourceMLBRuleV1() *schema.Resource {
 	var result *schema.Resource
 
@@ -37,6 +41,9 @@ func resourceMLBRuleV1() *schema.Resource {
 		Read:   resourceMLBRuleV1Read,
 		Update: resourceMLBRuleV1Update,
 		Delete: resourceMLBRuleV1Delete,
+
+		CustomizeDiff: resourceMLBV1StagedChangesPending(mlbRuleV1StagedKeys...),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -81,9 +88,23 @@ func resourceMLBRuleV1() *schema.Resource {
 				Computed: true,
 			},
 			"conditions": conditionsSchemaForResource(),
+			"configuration_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staged_changes_pending": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema, mlbRuleV1StagedKeys...)
+
 	return result
 }
 
@@ -116,14 +137,35 @@ func resourceMLBRuleV1Create(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Creating ECL managed load balancer rule with options %+v", createOpts)
 
-	rule, err := rules.Create(managedLoadBalancerClient, createOpts).Extract()
+	// load_balancer_id of the rule is known from its policy only, and is
+	// needed when the configurations are applied automatically.
+	loadBalancerID := ""
+	if config.MLBAutoApplyConfigurations {
+		policy, err := policies.Show(managedLoadBalancerClient, createOpts.PolicyID, policies.ShowOpts{}).Extract()
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve ECL managed load balancer policy (%s): %s", createOpts.PolicyID, err)
+		}
+		loadBalancerID = policy.LoadBalancerID
+	}
+
+	var rule *rules.Rule
+	err = config.mlbStageConfigurations(loadBalancerID, func() error {
+		rule, err = rules.Create(managedLoadBalancerClient, createOpts).Extract()
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating ECL managed load balancer rule with options %+v: %s", createOpts, err)
+		err = fmt.Errorf("Error creating ECL managed load balancer rule with options %+v: %s", createOpts, err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, loadBalancerID, err)
 	}
 
 	d.SetId(rule.ID)
 	log.Printf("[INFO] ECL managed load balancer rule ID: %s", rule.ID)
 
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, rule.LoadBalancerID)
+	if err != nil {
+		return err
+	}
+
 	return resourceMLBRuleV1Read(d, meta)
 }
 
@@ -175,12 +217,30 @@ func resourceMLBRuleV1Read(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	current := make([]interface{}, 0, 1)
+	if rule.ConfigurationStatus != "CREATE_STAGED" {
+		currentConditions := map[string]interface{}{
+			"path_patterns": rule.Conditions.PathPatterns,
+		}
+
+		current = append(current, map[string]interface{}{
+			"priority":               rule.Priority,
+			"target_group_id":        rule.TargetGroupID,
+			"backup_target_group_id": rule.BackupTargetGroupID,
+			"conditions":             []interface{}{currentConditions},
+		})
+	}
+
 	d.Set("name", rule.Name)
 	d.Set("description", rule.Description)
 	d.Set("tags", rule.Tags)
 	d.Set("policy_id", rule.PolicyID)
 	d.Set("load_balancer_id", rule.LoadBalancerID)
 	d.Set("tenant_id", rule.TenantID)
+	d.Set("configuration_status", rule.ConfigurationStatus)
+	d.Set("operation_status", rule.OperationStatus)
+	d.Set("staged_changes_pending", rule.ConfigurationStatus != "ACTIVE")
+	d.Set("current", current)
 	d.Set("conditions", []interface{}{conditions})
 
 	return nil
@@ -193,18 +253,28 @@ func resourceMLBRuleV1Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating ECL managed load balancer client: %s", err)
 	}
 
-	log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer rule ...")
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer rule ...")
+
+		if err := resourceMLBRuleV1UpdateAttributes(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating attributes of ECL managed load balancer rule: %s", err)
+		}
+
+		log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer rule ...")
 
-	err = resourceMLBRuleV1UpdateAttributes(d, managedLoadBalancerClient)
+		if err := resourceMLBRuleV1UpdateConfigurations(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating configurations of ECL managed load balancer rule: %s", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error in updating attributes of ECL managed load balancer rule: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
 	}
 
-	log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer rule ...")
-
-	err = resourceMLBRuleV1UpdateConfigurations(d, managedLoadBalancerClient)
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
 	if err != nil {
-		return fmt.Errorf("Error in updating configurations of ECL managed load balancer rule: %s", err)
+		return err
 	}
 
 	return resourceMLBRuleV1Read(d, meta)
@@ -342,7 +412,9 @@ func resourceMLBRuleV1Delete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Deleting ECL managed load balancer rule: %s", d.Id())
 
-	err = rules.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		return rules.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	})
 	if err != nil {
 		if _, ok := err.(eclcloud.ErrDefault404); ok {
 			log.Printf("[DEBUG] Already deleted ECL managed load balancer rule (%s)", d.Id())
@@ -358,7 +430,13 @@ func resourceMLBRuleV1Delete(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting ECL managed load balancer rule: %s", err)
+		err = fmt.Errorf("Error deleting ECL managed load balancer rule: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
+	}
+
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -33,6 +33,9 @@ func membersSchemaForResource() *schema.Schema {
 	}
 }
 
+// mlbTargetGroupV1StagedKeys are the keys of the staged configurations.
+var mlbTargetGroupV1StagedKeys = []string{"members"}
+
 func resourceMLBTargetGroupV1() *schema.Resource {
 	var result *schema.Resource
 
@@ -41,6 +44,9 @@ func resourceMLBTargetGroupV1() *schema.Resource {
 		Create: resourceMLBTargetGroupV1Create,
 		Update: resourceMLBTargetGroupV1Update,
 		Delete: resourceMLBTargetGroupV1Delete,
+
+		CustomizeDiff: resourceMLBV1StagedChangesPending(mlbTargetGroupV1StagedKeys...),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -68,9 +74,23 @@ func resourceMLBTargetGroupV1() *schema.Resource {
 				Computed: true,
 			},
 			"members": membersSchemaForResource(),
+			"configuration_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staged_changes_pending": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 
+	result.Schema["current"] = mlbCurrentConfigurationsSchemaV1(result.Schema, mlbTargetGroupV1StagedKeys...)
+
 	return result
 }
 
@@ -100,14 +120,24 @@ func resourceMLBTargetGroupV1Create(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] Creating ECL managed load balancer target group with options %+v", createOpts)
 
-	rule, err := target_groups.Create(managedLoadBalancerClient, createOpts).Extract()
+	var rule *target_groups.TargetGroup
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		rule, err = target_groups.Create(managedLoadBalancerClient, createOpts).Extract()
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating ECL managed load balancer target group with options %+v: %s", createOpts, err)
+		err = fmt.Errorf("Error creating ECL managed load balancer target group with options %+v: %s", createOpts, err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
 	}
 
 	d.SetId(rule.ID)
 	log.Printf("[INFO] ECL managed load balancer target group ID: %s", rule.ID)
 
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
+	if err != nil {
+		return err
+	}
+
 	return resourceMLBTargetGroupV1Read(d, meta)
 }
 
@@ -164,11 +194,31 @@ func resourceMLBTargetGroupV1Read(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 
+	current := make([]interface{}, 0, 1)
+	if targetGroup.ConfigurationStatus != "CREATE_STAGED" {
+		currentMembers := make([]interface{}, len(targetGroup.Members))
+		for i, member := range targetGroup.Members {
+			currentMembers[i] = map[string]interface{}{
+				"ip_address": member.IPAddress,
+				"port":       member.Port,
+				"weight":     member.Weight,
+			}
+		}
+
+		current = append(current, map[string]interface{}{
+			"members": currentMembers,
+		})
+	}
+
 	d.Set("name", targetGroup.Name)
 	d.Set("description", targetGroup.Description)
 	d.Set("tags", targetGroup.Tags)
 	d.Set("load_balancer_id", targetGroup.LoadBalancerID)
 	d.Set("tenant_id", targetGroup.TenantID)
+	d.Set("configuration_status", targetGroup.ConfigurationStatus)
+	d.Set("operation_status", targetGroup.OperationStatus)
+	d.Set("staged_changes_pending", targetGroup.ConfigurationStatus != "ACTIVE")
+	d.Set("current", current)
 
 	return nil
 }
@@ -180,18 +230,28 @@ func resourceMLBTargetGroupV1Update(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error creating ECL managed load balancer client: %s", err)
 	}
 
-	log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer target group ...")
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		log.Printf("[DEBUG] Start updating attributes of ECL managed load balancer target group ...")
+
+		if err := resourceMLBTargetGroupV1UpdateAttributes(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating attributes of ECL managed load balancer target group: %s", err)
+		}
+
+		log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer target group ...")
 
-	err = resourceMLBTargetGroupV1UpdateAttributes(d, managedLoadBalancerClient)
+		if err := resourceMLBTargetGroupV1UpdateConfigurations(d, managedLoadBalancerClient); err != nil {
+			return fmt.Errorf("Error in updating configurations of ECL managed load balancer target group: %s", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error in updating attributes of ECL managed load balancer target group: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
 	}
 
-	log.Printf("[DEBUG] Start updating configurations of ECL managed load balancer target group ...")
-
-	err = resourceMLBTargetGroupV1UpdateConfigurations(d, managedLoadBalancerClient)
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
 	if err != nil {
-		return fmt.Errorf("Error in updating configurations of ECL managed load balancer target group: %s", err)
+		return err
 	}
 
 	return resourceMLBTargetGroupV1Read(d, meta)
@@ -306,7 +366,9 @@ func resourceMLBTargetGroupV1Delete(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] Deleting ECL managed load balancer target group: %s", d.Id())
 
-	err = target_groups.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	err = config.mlbStageConfigurations(d.Get("load_balancer_id").(string), func() error {
+		return target_groups.Delete(managedLoadBalancerClient, d.Id()).ExtractErr()
+	})
 	if err != nil {
 		if _, ok := err.(eclcloud.ErrDefault404); ok {
 			log.Printf("[DEBUG] Already deleted ECL managed load balancer target group (%s)", d.Id())
@@ -322,7 +384,13 @@ func resourceMLBTargetGroupV1Delete(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting ECL managed load balancer target group: %s", err)
+		err = fmt.Errorf("Error deleting ECL managed load balancer target group: %s", err)
+		return config.mlbAutoApplyConfigurationsFailed(managedLoadBalancerClient, d.Get("load_balancer_id").(string), err)
+	}
+
+	err = config.mlbAutoApplyConfigurations(managedLoadBalancerClient, d.Get("load_balancer_id").(string))
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -12,6 +15,7 @@ import (
 
 	"github.com/nttcom/eclcloud/v3"
 	"github.com/nttcom/eclcloud/v3/ecl/network/v2/gateway_interfaces"
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/subnets"
 )
 
 func resourceNetworkGatewayInterfaceV2() *schema.Resource {
@@ -30,6 +34,8 @@ func resourceNetworkGatewayInterfaceV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: resourceNetworkGatewayInterfaceV2CustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:       schema.TypeString,
@@ -68,7 +74,8 @@ func resourceNetworkGatewayInterfaceV2() *schema.Resource {
 			},
 			"gw_vipv4": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.SingleIP(),
 			},
@@ -94,7 +101,8 @@ func resourceNetworkGatewayInterfaceV2() *schema.Resource {
 			},
 			"netmask": {
 				Type:     schema.TypeInt,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"network_id": {
@@ -104,7 +112,8 @@ func resourceNetworkGatewayInterfaceV2() *schema.Resource {
 			},
 			"primary_ipv4": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.SingleIP(),
 			},
@@ -114,7 +123,8 @@ func resourceNetworkGatewayInterfaceV2() *schema.Resource {
 			},
 			"secondary_ipv4": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.SingleIP(),
 			},
@@ -130,6 +140,11 @@ func resourceNetworkGatewayInterfaceV2() *schema.Resource {
 					"aws", "azure", "fic", "gcp", "vpn", "internet", "interdc",
 				}, true),
 			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"tenant_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -143,9 +158,10 @@ func resourceNetworkGatewayInterfaceV2() *schema.Resource {
 				ConflictsWith: []string{"aws_gw_id", "azure_gw_id", "gcp_gw_id", "fic_gw_id", "interdc_gw_id", "internet_gw_id"},
 			},
 			"vrid": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateNetworkGatewayInterfaceV2VRID,
 			},
 		},
 	}
@@ -178,6 +194,14 @@ func resourceNetworkGatewayInterfaceV2Create(d *schema.ResourceData, meta interf
 		VRID:          d.Get("vrid").(int),
 	}
 
+	if subnetID := d.Get("subnet_id").(string); subnetID != "" {
+		if err := resourceNetworkGatewayInterfaceV2DeriveAddresses(networkClient, subnetID, &createOpts); err != nil {
+			return err
+		}
+	} else if createOpts.GwVipv4 == "" || createOpts.PrimaryIpv4 == "" || createOpts.SecondaryIpv4 == "" || createOpts.Netmask == 0 {
+		return fmt.Errorf("gw_vipv4, primary_ipv4, secondary_ipv4 and netmask must be set unless subnet_id is set")
+	}
+
 	i, err := gateway_interfaces.Create(networkClient, createOpts).Extract()
 	if err != nil {
 		return fmt.Errorf("error creating ECL Gateway interface: %w", err)
@@ -341,3 +365,173 @@ func waitForGatewayInterfaceDelete(networkClient *eclcloud.ServiceClient, gatewa
 
 	}
 }
+
+// resourceNetworkGatewayInterfaceV2CustomizeDiff validates the gateway of the
+// service type and the VRRP addresses at plan time instead of at creation.
+func resourceNetworkGatewayInterfaceV2CustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("service_type") {
+		serviceType := strings.ToLower(d.Get("service_type").(string))
+		if key, ok := networkGatewayInterfaceV2GatewayIDKeys[serviceType]; ok && d.NewValueKnown(key) && d.Get(key).(string) == "" {
+			return fmt.Errorf("%s must be set for service_type %q", key, serviceType)
+		}
+
+		var keys []string
+		for st, key := range networkGatewayInterfaceV2GatewayIDKeys {
+			if st != serviceType {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if d.NewValueKnown(key) && d.Get(key).(string) != "" {
+				return fmt.Errorf("%s can not be set for service_type %q", key, serviceType)
+			}
+		}
+	}
+
+	// The addresses are derived from subnet_id on creation when it is set.
+	// Unset addresses are not known at plan time, so Create checks them again.
+	if d.Id() == "" && d.NewValueKnown("subnet_id") && d.Get("subnet_id").(string) == "" {
+		missing := d.NewValueKnown("netmask") && d.Get("netmask").(int) == 0
+		for _, k := range networkGatewayInterfaceV2AddressKeys {
+			missing = missing || (d.NewValueKnown(k) && d.Get(k).(string) == "")
+		}
+		if missing {
+			return fmt.Errorf("gw_vipv4, primary_ipv4, secondary_ipv4 and netmask must be set unless subnet_id is set")
+		}
+	}
+
+	if d.Id() != "" {
+		changed := false
+		for _, k := range append([]string{"netmask", "network_id", "subnet_id"}, networkGatewayInterfaceV2AddressKeys...) {
+			changed = changed || d.HasChange(k)
+		}
+		if !changed {
+			return nil
+		}
+	}
+
+	addresses := make(map[string]string)
+	for _, k := range networkGatewayInterfaceV2AddressKeys {
+		if d.NewValueKnown(k) {
+			addresses[k] = d.Get(k).(string)
+		}
+	}
+
+	var netmask int
+	if d.NewValueKnown("netmask") {
+		netmask = d.Get("netmask").(int)
+	}
+
+	cidr, err := resourceNetworkGatewayInterfaceV2SubnetCIDR(d, meta)
+	if err != nil {
+		return err
+	}
+
+	return networkGatewayInterfaceV2ValidateAddresses(addresses, netmask, cidr)
+}
+
+// resourceNetworkGatewayInterfaceV2SubnetCIDR returns the CIDR of subnet_id,
+// or of the subnet of the network containing gw_vipv4. It returns an empty
+// string if the subnet is not known yet.
+func resourceNetworkGatewayInterfaceV2SubnetCIDR(d *schema.ResourceDiff, meta interface{}) (string, error) {
+	if !d.NewValueKnown("subnet_id") {
+		return "", nil
+	}
+
+	networkID := ""
+	if d.NewValueKnown("network_id") {
+		networkID = d.Get("network_id").(string)
+	}
+
+	subnetID := d.Get("subnet_id").(string)
+	gwVIP := net.ParseIP(d.Get("gw_vipv4").(string))
+	if subnetID == "" && (networkID == "" || !d.NewValueKnown("gw_vipv4") || gwVIP == nil) {
+		return "", nil
+	}
+
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegionFromDiff(d, config))
+	if err != nil {
+		return "", fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	if subnetID != "" {
+		subnet, err := subnets.Get(networkClient, subnetID).Extract()
+		if err != nil {
+			return "", fmt.Errorf("error getting ECL subnet %s: %w", subnetID, err)
+		}
+		if networkID != "" && subnet.NetworkID != networkID {
+			return "", fmt.Errorf("subnet %s does not belong to network %s", subnetID, networkID)
+		}
+		return subnet.CIDR, nil
+	}
+
+	allPages, err := subnets.List(networkClient, subnets.ListOpts{NetworkID: networkID, IPVersion: 4}).AllPages()
+	if err != nil {
+		return "", fmt.Errorf("error listing ECL subnets of network %s: %w", networkID, err)
+	}
+
+	allSubnets, err := subnets.ExtractSubnets(allPages)
+	if err != nil {
+		return "", fmt.Errorf("error extracting ECL subnets of network %s: %w", networkID, err)
+	}
+
+	for _, s := range allSubnets {
+		if _, ipNet, err := net.ParseCIDR(s.CIDR); err == nil && ipNet.Contains(gwVIP) {
+			return s.CIDR, nil
+		}
+	}
+
+	// The subnet may be created along with the gateway interface.
+	return "", nil
+}
+
+// resourceNetworkGatewayInterfaceV2DeriveAddresses fills the netmask and the
+// addresses which are not set from the CIDR and the unused addresses of the
+// subnet.
+func resourceNetworkGatewayInterfaceV2DeriveAddresses(networkClient *eclcloud.ServiceClient, subnetID string, createOpts *gateway_interfaces.CreateOpts) error {
+	subnet, err := subnets.Get(networkClient, subnetID).Extract()
+	if err != nil {
+		return fmt.Errorf("error getting ECL subnet %s: %w", subnetID, err)
+	}
+
+	if createOpts.Netmask == 0 {
+		_, ipNet, err := net.ParseCIDR(subnet.CIDR)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR %q of ECL subnet %s: %w", subnet.CIDR, subnetID, err)
+		}
+		createOpts.Netmask, _ = ipNet.Mask.Size()
+	}
+
+	addresses := []*string{&createOpts.GwVipv4, &createOpts.PrimaryIpv4, &createOpts.SecondaryIpv4}
+
+	var excluded []string
+	count := 0
+	for _, address := range addresses {
+		if *address == "" {
+			count++
+		} else {
+			excluded = append(excluded, *address)
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+
+	ipAddresses, err := networkSubnetV2AllocateIPs(networkClient, subnet, excluded, count)
+	if err != nil {
+		return fmt.Errorf("error deriving addresses of ECL Gateway interface: %w", err)
+	}
+
+	for _, address := range addresses {
+		if *address == "" {
+			*address, ipAddresses = ipAddresses[0], ipAddresses[1:]
+		}
+	}
+
+	log.Printf("[DEBUG] Derived addresses of ECL Gateway interface from subnet %s: gw_vipv4=%s primary_ipv4=%s secondary_ipv4=%s netmask=%d",
+		subnetID, createOpts.GwVipv4, createOpts.PrimaryIpv4, createOpts.SecondaryIpv4, createOpts.Netmask)
+
+	return nil
+}
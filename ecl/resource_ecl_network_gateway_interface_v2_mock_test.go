@@ -0,0 +1,350 @@
+package ecl
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedNetworkV2GatewayInterface_subnet(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "subnets", "/v2.0/subnets/9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01", testMockNetworkV2GatewayInterfaceGetSubnet)
+	mc.Register(t, "ports", "/v2.0/ports", testMockNetworkV2GatewayInterfaceListPorts)
+	mc.Register(t, "gateway_interfaces", "/v2.0/gw_interfaces", testMockNetworkV2GatewayInterfacePost)
+	mc.Register(t, "gateway_interfaces", "/v2.0/gw_interfaces/5d6e7f8a-4444-4b9c-8d0e-1f2a3b4c5d01", testMockNetworkV2GatewayInterfaceGetPendingCreate)
+	mc.Register(t, "gateway_interfaces", "/v2.0/gw_interfaces/5d6e7f8a-4444-4b9c-8d0e-1f2a3b4c5d01", testMockNetworkV2GatewayInterfaceGetActive)
+	mc.Register(t, "gateway_interfaces", "/v2.0/gw_interfaces/5d6e7f8a-4444-4b9c-8d0e-1f2a3b4c5d01", testMockNetworkV2GatewayInterfaceDelete)
+	mc.Register(t, "gateway_interfaces", "/v2.0/gw_interfaces/5d6e7f8a-4444-4b9c-8d0e-1f2a3b4c5d01", testMockNetworkV2GatewayInterfaceGetDeleted)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2GatewayInterfaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockNetworkV2GatewayInterfaceSubnet,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ecl_network_gateway_interface_v2.gateway_interface_1", "gw_vipv4", "192.168.200.2"),
+					resource.TestCheckResourceAttr("ecl_network_gateway_interface_v2.gateway_interface_1", "primary_ipv4", "192.168.200.3"),
+					resource.TestCheckResourceAttr("ecl_network_gateway_interface_v2.gateway_interface_1", "secondary_ipv4", "192.168.200.4"),
+					resource.TestCheckResourceAttr("ecl_network_gateway_interface_v2.gateway_interface_1", "netmask", "29"),
+					resource.TestCheckResourceAttr("ecl_network_gateway_interface_v2.gateway_interface_1", "subnet_id", "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"),
+				),
+			},
+		},
+	})
+}
+
+func TestMockedNetworkV2GatewayInterface_invalid(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "subnets", "/v2.0/subnets/9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01", testMockNetworkV2GatewayInterfaceGetSubnet)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testMockNetworkV2GatewayInterfaceInvalidVRID,
+				ExpectError: regexp.MustCompile(`expected vrid to be in the range`),
+			},
+			resource.TestStep{
+				Config:      testMockNetworkV2GatewayInterfaceNetmaskMismatch,
+				ExpectError: regexp.MustCompile(`netmask 28 does not match the subnet CIDR 192.168.200.0/29`),
+			},
+			resource.TestStep{
+				Config:      testMockNetworkV2GatewayInterfaceSameAddress,
+				ExpectError: regexp.MustCompile(`primary_ipv4 and secondary_ipv4 must be different addresses`),
+			},
+			resource.TestStep{
+				Config:      testMockNetworkV2GatewayInterfaceWrongGateway,
+				ExpectError: regexp.MustCompile(`internet_gw_id must be set for service_type "internet"`),
+			},
+			resource.TestStep{
+				Config:      testMockNetworkV2GatewayInterfaceMissingAddresses,
+				ExpectError: regexp.MustCompile(`gw_vipv4, primary_ipv4, secondary_ipv4 and netmask must be set unless subnet_id is set`),
+			},
+		},
+	})
+}
+
+const testMockNetworkV2GatewayInterfaceSubnet = `
+resource "ecl_network_gateway_interface_v2" "gateway_interface_1" {
+  internet_gw_id = "7e8f9a0b-5555-4c1d-9e2f-3a4b5c6d7e01"
+  network_id = "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01"
+  subnet_id = "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+  service_type = "internet"
+  vrid = 1
+}
+`
+
+const testMockNetworkV2GatewayInterfaceNetmaskMismatch = `
+resource "ecl_network_gateway_interface_v2" "gateway_interface_1" {
+  gw_vipv4 = "192.168.200.1"
+  internet_gw_id = "7e8f9a0b-5555-4c1d-9e2f-3a4b5c6d7e01"
+  netmask = 28
+  network_id = "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01"
+  primary_ipv4 = "192.168.200.2"
+  secondary_ipv4 = "192.168.200.3"
+  subnet_id = "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+  service_type = "internet"
+  vrid = 1
+}
+`
+
+const testMockNetworkV2GatewayInterfaceSameAddress = `
+resource "ecl_network_gateway_interface_v2" "gateway_interface_1" {
+  gw_vipv4 = "192.168.200.1"
+  internet_gw_id = "7e8f9a0b-5555-4c1d-9e2f-3a4b5c6d7e01"
+  netmask = 29
+  network_id = "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01"
+  primary_ipv4 = "192.168.200.2"
+  secondary_ipv4 = "192.168.200.2"
+  subnet_id = "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+  service_type = "internet"
+  vrid = 1
+}
+`
+
+const testMockNetworkV2GatewayInterfaceWrongGateway = `
+resource "ecl_network_gateway_interface_v2" "gateway_interface_1" {
+  vpn_gw_id = "7e8f9a0b-5555-4c1d-9e2f-3a4b5c6d7e01"
+  network_id = "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01"
+  subnet_id = "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+  service_type = "internet"
+  vrid = 1
+}
+`
+
+const testMockNetworkV2GatewayInterfaceMissingAddresses = `
+resource "ecl_network_gateway_interface_v2" "gateway_interface_1" {
+  internet_gw_id = "7e8f9a0b-5555-4c1d-9e2f-3a4b5c6d7e01"
+  network_id = "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01"
+  service_type = "internet"
+  vrid = 1
+}
+`
+
+const testMockNetworkV2GatewayInterfaceInvalidVRID = `
+resource "ecl_network_gateway_interface_v2" "gateway_interface_1" {
+  internet_gw_id = "7e8f9a0b-5555-4c1d-9e2f-3a4b5c6d7e01"
+  network_id = "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01"
+  subnet_id = "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+  service_type = "internet"
+  vrid = 256
+}
+`
+
+var testMockNetworkV2GatewayInterfaceGetSubnet = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "subnet": {
+            "allocation_pools": [
+              {
+                "end": "192.168.200.6",
+                "start": "192.168.200.1"
+              }
+            ],
+            "cidr": "192.168.200.0/29",
+            "description": "",
+            "dns_nameservers": [],
+            "enable_dhcp": false,
+            "gateway_ip": null,
+            "host_routes": [],
+            "id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01",
+            "ip_version": 4,
+            "name": "subnet_1",
+            "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+            "ntp_servers": [],
+            "status": "ACTIVE",
+            "tags": {},
+            "tenant_id": "%s"
+          }
+        }
+`, OS_TENANT_ID)
+
+var testMockNetworkV2GatewayInterfaceListPorts = fmt.Sprintf(`
+request:
+    method: GET
+    query:
+        network_id:
+            - 8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01
+response:
+    code: 200
+    body: >
+        {
+          "ports": [
+            {
+              "admin_state_up": true,
+              "allowed_address_pairs": [],
+              "description": "",
+              "device_id": "",
+              "device_owner": "",
+              "fixed_ips": [
+                {
+                  "ip_address": "192.168.200.1",
+                  "subnet_id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+                }
+              ],
+              "id": "6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01",
+              "mac_address": "fa:16:3e:00:00:01",
+              "name": "port_1",
+              "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+              "segmentation_id": 0,
+              "segmentation_type": "flat",
+              "status": "ACTIVE",
+              "tags": {},
+              "tenant_id": "%s"
+            }
+          ]
+        }
+`, OS_TENANT_ID)
+
+var testMockNetworkV2GatewayInterfacePost = fmt.Sprintf(`
+request:
+    method: POST
+    body: >
+        {"gw_interface":{"description":"","gw_vipv4":"192.168.200.2","internet_gw_id":"7e8f9a0b-5555-4c1d-9e2f-3a4b5c6d7e01","name":"","netmask":29,"network_id":"8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01","primary_ipv4":"192.168.200.3","secondary_ipv4":"192.168.200.4","service_type":"internet","vrid":1}}
+response:
+    code: 201
+    body: >
+        {
+          "gw_interface": {
+            "aws_gw_id": null,
+            "azure_gw_id": null,
+            "description": "",
+            "fic_gw_id": null,
+            "gcp_gw_id": null,
+            "gw_vipv4": "192.168.200.2",
+            "gw_vipv6": null,
+            "id": "5d6e7f8a-4444-4b9c-8d0e-1f2a3b4c5d01",
+            "interdc_gw_id": null,
+            "internet_gw_id": "7e8f9a0b-5555-4c1d-9e2f-3a4b5c6d7e01",
+            "name": "",
+            "netmask": 29,
+            "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+            "primary_ipv4": "192.168.200.3",
+            "primary_ipv6": null,
+            "secondary_ipv4": "192.168.200.4",
+            "secondary_ipv6": null,
+            "service_type": "internet",
+            "status": "PENDING_CREATE",
+            "tenant_id": "%s",
+            "vpn_gw_id": null,
+            "vrid": 1
+          }
+        }
+newStatus: Created
+`, OS_TENANT_ID)
+
+var testMockNetworkV2GatewayInterfaceGetPendingCreate = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "gw_interface": {
+            "aws_gw_id": null,
+            "azure_gw_id": null,
+            "description": "",
+            "fic_gw_id": null,
+            "gcp_gw_id": null,
+            "gw_vipv4": "192.168.200.2",
+            "gw_vipv6": null,
+            "id": "5d6e7f8a-4444-4b9c-8d0e-1f2a3b4c5d01",
+            "interdc_gw_id": null,
+            "internet_gw_id": "7e8f9a0b-5555-4c1d-9e2f-3a4b5c6d7e01",
+            "name": "",
+            "netmask": 29,
+            "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+            "primary_ipv4": "192.168.200.3",
+            "primary_ipv6": null,
+            "secondary_ipv4": "192.168.200.4",
+            "secondary_ipv6": null,
+            "service_type": "internet",
+            "status": "PENDING_CREATE",
+            "tenant_id": "%s",
+            "vpn_gw_id": null,
+            "vrid": 1
+          }
+        }
+expectedStatus:
+    - Created
+counter:
+  max: 1
+`, OS_TENANT_ID)
+
+var testMockNetworkV2GatewayInterfaceGetActive = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "gw_interface": {
+            "aws_gw_id": null,
+            "azure_gw_id": null,
+            "description": "",
+            "fic_gw_id": null,
+            "gcp_gw_id": null,
+            "gw_vipv4": "192.168.200.2",
+            "gw_vipv6": null,
+            "id": "5d6e7f8a-4444-4b9c-8d0e-1f2a3b4c5d01",
+            "interdc_gw_id": null,
+            "internet_gw_id": "7e8f9a0b-5555-4c1d-9e2f-3a4b5c6d7e01",
+            "name": "",
+            "netmask": 29,
+            "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+            "primary_ipv4": "192.168.200.3",
+            "primary_ipv6": null,
+            "secondary_ipv4": "192.168.200.4",
+            "secondary_ipv6": null,
+            "service_type": "internet",
+            "status": "ACTIVE",
+            "tenant_id": "%s",
+            "vpn_gw_id": null,
+            "vrid": 1
+          }
+        }
+expectedStatus:
+    - Created
+counter:
+  min: 2
+`, OS_TENANT_ID)
+
+var testMockNetworkV2GatewayInterfaceDelete = `
+request:
+    method: DELETE
+response:
+    code: 204
+expectedStatus:
+    - Created
+newStatus: Deleted
+`
+
+var testMockNetworkV2GatewayInterfaceGetDeleted = `
+request:
+    method: GET
+response:
+    code: 404
+expectedStatus:
+    - Deleted
+`
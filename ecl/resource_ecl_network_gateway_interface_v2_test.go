@@ -161,6 +161,37 @@ func TestAccNetworkV2GatewayInterface_fic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkV2GatewayInterface_subnet(t *testing.T) {
+	var gatewayInterface gateway_interfaces.GatewayInterface
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "ecl_network_gateway_interface_v2.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckGatewayInterfaceInternet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2GatewayInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkV2GatewayInterfaceSubnetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkV2GatewayInterfaceExists(resourceName, &gatewayInterface),
+					resource.TestCheckResourceAttr(resourceName, "gw_vipv4", "192.168.200.1"),
+					resource.TestCheckResourceAttr(resourceName, "netmask", "29"),
+					resource.TestCheckResourceAttr(resourceName, "primary_ipv4", "192.168.200.2"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_ipv4", "192.168.200.3"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "ecl_network_subnet_v2.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"subnet_id"},
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkV2GatewayInterfaceDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkClient, err := config.networkV2Client(OS_REGION_NAME)
@@ -285,3 +316,39 @@ resource "ecl_network_gateway_interface_v2" "test" {
 }
 `, rName, nameSuffix, description, OS_FIC_GW_ID)
 }
+
+func testAccNetworkV2GatewayInterfaceSubnetConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "ecl_network_network_v2" "test" {
+    name = %[1]q
+}
+
+resource "ecl_network_subnet_v2" "test" {
+    name = %[1]q
+    cidr = "192.168.200.0/29"
+    enable_dhcp = false
+    no_gateway = true
+    network_id = ecl_network_network_v2.test.id
+}
+
+data "ecl_network_internet_service_v2" "test" {
+	name = "Internet-Service-01"
+}
+
+resource "ecl_network_internet_gateway_v2" "test" {
+    name = %[1]q
+    description = "test"
+    internet_service_id = data.ecl_network_internet_service_v2.test.id
+    qos_option_id = %[2]q
+}
+
+resource "ecl_network_gateway_interface_v2" "test" {
+    internet_gw_id = ecl_network_internet_gateway_v2.test.id
+    name = %[1]q
+    network_id = ecl_network_network_v2.test.id
+    subnet_id = ecl_network_subnet_v2.test.id
+    service_type = "internet"
+    vrid = 1
+}
+`, rName, OS_QOS_OPTION_ID_10M)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/nttcom/eclcloud/v3"
 	"github.com/nttcom/eclcloud/v3/ecl/network/v2/internet_gateways"
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/qos_options"
 )
 
 func resourceNetworkInternetGatewayV2() *schema.Resource {
@@ -22,6 +23,8 @@ func resourceNetworkInternetGatewayV2() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceNetworkInternetGatewayV2CustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -139,6 +142,24 @@ func resourceNetworkInternetGatewayV2Update(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Error creating ECL network client: %s", err)
 	}
 
+	// The gateway rejects updates while it is still being created or updated.
+	log.Printf("[DEBUG] Waiting for Internet gateway (%s) to become available before update", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING_CREATE", "PENDING_UPDATE"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    waitForInternetGatewayActive(networkClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for internet_gateway (%s) to become ready: %s",
+			d.Id(), err)
+	}
+
 	var updateOpts internet_gateways.UpdateOpts
 	var description string
 	var name string
@@ -164,11 +185,12 @@ func resourceNetworkInternetGatewayV2Update(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Error updating ECL Internet gateway: %s", err)
 	}
 
-	stateConf := &resource.StateChangeConf{
+	log.Printf("[DEBUG] Waiting for Internet gateway (%s) to be updated", d.Id())
+	stateConf = &resource.StateChangeConf{
 		Pending:    []string{"PENDING_UPDATE"},
 		Target:     []string{"ACTIVE"},
 		Refresh:    waitForInternetGatewayActive(networkClient, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -181,6 +203,44 @@ func resourceNetworkInternetGatewayV2Update(d *schema.ResourceData, meta interfa
 	return resourceNetworkInternetGatewayV2Read(d, meta)
 }
 
+// resourceNetworkInternetGatewayV2CustomizeDiff checks that qos_option_id is
+// a QoS option of the internet service of the gateway.
+func resourceNetworkInternetGatewayV2CustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("qos_option_id") {
+		return nil
+	}
+
+	if !d.NewValueKnown("qos_option_id") || !d.NewValueKnown("internet_service_id") {
+		return nil
+	}
+
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegionFromDiff(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	qosOptionID := d.Get("qos_option_id").(string)
+	qosOption, err := qos_options.Get(networkClient, qosOptionID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving ECL QoS option %s: %s", qosOptionID, err)
+	}
+
+	if qosOption.ServiceType != "internet" {
+		return fmt.Errorf(
+			"QoS option %s is for service type %s, not internet", qosOptionID, qosOption.ServiceType)
+	}
+
+	internetServiceID := d.Get("internet_service_id").(string)
+	if qosOption.InternetServiceID != internetServiceID {
+		return fmt.Errorf(
+			"QoS option %s belongs to internet service %s, not %s",
+			qosOptionID, qosOption.InternetServiceID, internetServiceID)
+	}
+
+	return nil
+}
+
 func resourceNetworkInternetGatewayV2Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	networkClient, err := config.networkV2Client(GetRegion(d, config))
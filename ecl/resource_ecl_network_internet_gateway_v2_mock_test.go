@@ -2,6 +2,7 @@ package ecl
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -19,6 +20,7 @@ func TestMockedAccNetworkV2InternetGateway_basic(t *testing.T) {
 	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
 	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
 	mc.Register(t, "internet_service", "/v2.0/internet_services", testMockNetworkV2InternetServiceListNameQuery)
+	mc.Register(t, "qos_options", "/v2.0/qos_options/", testMockNetworkV2InternetGatewayGetQoSOption)
 	mc.Register(t, "internet_gateway", "/v2.0/internet_gateways", testMockNetworkV2InternetGatewayPost)
 	mc.Register(t, "internet_gateway", "/v2.0/internet_gateways/", testMockNetworkV2InternetGatewayGetBasic)
 	mc.Register(t, "internet_gateway", "/v2.0/internet_gateways/", testMockNetworkV2InternetGatewayGetPendingCreate)
@@ -67,6 +69,126 @@ func TestMockedAccNetworkV2InternetGateway_basic(t *testing.T) {
 	})
 }
 
+func TestMockedNetworkV2InternetGateway_invalidQoSOption(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "qos_options", "/v2.0/qos_options/0b6b4d0a-4e1c-4c38-9d34-3ab6a4b1f201", testMockNetworkV2InternetGatewayGetQoSOptionOtherService)
+	mc.Register(t, "qos_options", "/v2.0/qos_options/0b6b4d0a-4e1c-4c38-9d34-3ab6a4b1f202", testMockNetworkV2InternetGatewayGetQoSOptionVPN)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testMockNetworkV2InternetGatewayQoSOptionOtherService,
+				ExpectError: regexp.MustCompile(`belongs to internet service 5536154d-9a00-4b11-81fb-b185c9111d90, not a7791c79-19b0-4eb6-9a8f-ea739b44e8d5`),
+			},
+			resource.TestStep{
+				Config:      testMockNetworkV2InternetGatewayQoSOptionVPN,
+				ExpectError: regexp.MustCompile(`is for service type vpn, not internet`),
+			},
+		},
+	})
+}
+
+const testMockNetworkV2InternetGatewayQoSOptionOtherService = `
+resource "ecl_network_internet_gateway_v2" "internet_gateway_1" {
+  name = "Terraform_Test_Internet_Gateway_01"
+  internet_service_id = "a7791c79-19b0-4eb6-9a8f-ea739b44e8d5"
+  qos_option_id = "0b6b4d0a-4e1c-4c38-9d34-3ab6a4b1f201"
+}
+`
+
+const testMockNetworkV2InternetGatewayQoSOptionVPN = `
+resource "ecl_network_internet_gateway_v2" "internet_gateway_1" {
+  name = "Terraform_Test_Internet_Gateway_01"
+  internet_service_id = "a7791c79-19b0-4eb6-9a8f-ea739b44e8d5"
+  qos_option_id = "0b6b4d0a-4e1c-4c38-9d34-3ab6a4b1f202"
+}
+`
+
+var testMockNetworkV2InternetGatewayGetQoSOption = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "qos_option": {
+                "aws_service_id": null,
+                "azure_service_id": null,
+                "bandwidth": "10",
+                "description": "10M-besteffort-menu",
+                "fic_service_id": null,
+                "gcp_service_id": null,
+                "id": "a6b91294-8870-4f2c-b9e9-a899acada723",
+                "interdc_service_id": null,
+                "internet_service_id": "a7791c79-19b0-4eb6-9a8f-ea739b44e8d5",
+                "name": "10Mbps-BestEffort",
+                "qos_type": "besteffort",
+                "service_type": "internet",
+                "status": "ACTIVE",
+                "vpn_service_id": null
+            }
+        }
+`
+
+var testMockNetworkV2InternetGatewayGetQoSOptionOtherService = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "qos_option": {
+                "aws_service_id": null,
+                "azure_service_id": null,
+                "bandwidth": "10",
+                "description": "10M-besteffort-menu",
+                "fic_service_id": null,
+                "gcp_service_id": null,
+                "id": "0b6b4d0a-4e1c-4c38-9d34-3ab6a4b1f201",
+                "interdc_service_id": null,
+                "internet_service_id": "5536154d-9a00-4b11-81fb-b185c9111d90",
+                "name": "10Mbps-BestEffort",
+                "qos_type": "besteffort",
+                "service_type": "internet",
+                "status": "ACTIVE",
+                "vpn_service_id": null
+            }
+        }
+`
+
+var testMockNetworkV2InternetGatewayGetQoSOptionVPN = `
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+            "qos_option": {
+                "aws_service_id": null,
+                "azure_service_id": null,
+                "bandwidth": "10",
+                "description": "10M-besteffort-menu",
+                "fic_service_id": null,
+                "gcp_service_id": null,
+                "id": "0b6b4d0a-4e1c-4c38-9d34-3ab6a4b1f202",
+                "interdc_service_id": null,
+                "internet_service_id": null,
+                "name": "10Mbps-BestEffort",
+                "qos_type": "besteffort",
+                "service_type": "vpn",
+                "status": "ACTIVE",
+                "vpn_service_id": "2ac1ff3c-59b0-4b8c-a5b5-8a9d0b2c4e11"
+            }
+        }
+`
+
 var testMockNetworkV2InternetGatewayPost = fmt.Sprintf(`
 request:
     method: POST
@@ -0,0 +1,257 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/load_balancer_interfaces"
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/load_balancers"
+)
+
+var loadBalancerInterfaceV2Keys = []string{
+	"description",
+	"ip_address",
+	"name",
+	"network_id",
+	"virtual_ip_address",
+	"virtual_ip_properties",
+}
+
+func resourceNetworkLoadBalancerInterfaceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkLoadBalancerInterfaceV2Create,
+		Read:   resourceNetworkLoadBalancerInterfaceV2Read,
+		Update: resourceNetworkLoadBalancerInterfaceV2Update,
+		Delete: resourceNetworkLoadBalancerInterfaceV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"slot_number": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"virtual_ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
+
+			"virtual_ip_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"vrrp",
+							}, false),
+						},
+						"vrid": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 255),
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkLoadBalancerInterfaceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	loadBalancerID := d.Get("load_balancer_id").(string)
+	slotNumber := d.Get("slot_number").(int)
+
+	osMutexKV.Lock(loadBalancerID)
+	defer osMutexKV.Unlock(loadBalancerID)
+
+	loadBalancer, err := load_balancers.Get(networkClient, loadBalancerID).Extract()
+	if err != nil {
+		return fmt.Errorf("error getting ECL load balancer: %w", err)
+	}
+
+	var id string
+	for _, e := range loadBalancer.Interfaces {
+		if e.SlotNumber == slotNumber {
+			id = e.ID
+			break
+		}
+	}
+	if id == "" {
+		return fmt.Errorf("invalid slot number: %d", slotNumber)
+	}
+
+	_, n := resourceNetworkLoadBalancerInterfaceV2Change(d)
+	updateOpts := expandLoadBalancerInterfaceInitialUpdateOpts(n)
+	if err := updateLoadBalancerInterface(networkClient, d, loadBalancerID, id, *updateOpts); err != nil {
+		return fmt.Errorf("error updating Load Balancer Interface: %w", err)
+	}
+
+	d.SetId(id)
+
+	return resourceNetworkLoadBalancerInterfaceV2Read(d, meta)
+}
+
+func resourceNetworkLoadBalancerInterfaceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	lbInterface, err := load_balancer_interfaces.Get(networkClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "error getting Load Balancer Interface")
+	}
+
+	log.Printf("[DEBUG] Retrieved Load Balancer Interface %s: %+v", d.Id(), lbInterface)
+
+	d.Set("load_balancer_id", lbInterface.LoadBalancerID)
+	d.Set("slot_number", lbInterface.SlotNumber)
+	d.Set("description", lbInterface.Description)
+	d.Set("ip_address", lbInterface.IPAddress)
+	d.Set("name", lbInterface.Name)
+	d.Set("network_id", lbInterface.NetworkID)
+	d.Set("virtual_ip_address", lbInterface.VirtualIPAddress)
+	d.Set("status", lbInterface.Status)
+	d.Set("tenant_id", lbInterface.TenantID)
+	d.Set("type", lbInterface.Type)
+
+	var virtualIPProperties []interface{}
+	if v := lbInterface.VirtualIPProperties; v != nil {
+		virtualIPProperties = append(virtualIPProperties, map[string]interface{}{
+			"protocol": v.Protocol,
+			"vrid":     v.Vrid,
+		})
+	}
+	d.Set("virtual_ip_properties", virtualIPProperties)
+
+	return nil
+}
+
+func resourceNetworkLoadBalancerInterfaceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	loadBalancerID := d.Get("load_balancer_id").(string)
+
+	osMutexKV.Lock(loadBalancerID)
+	defer osMutexKV.Unlock(loadBalancerID)
+
+	o, n := resourceNetworkLoadBalancerInterfaceV2Change(d)
+	if updateOpts := expandLoadBalancerInterfaceChanges(o, n); updateOpts != nil {
+		if err := updateLoadBalancerInterface(networkClient, d, loadBalancerID, d.Id(), *updateOpts); err != nil {
+			return fmt.Errorf("error updating Load Balancer Interface: %w", err)
+		}
+	}
+
+	return resourceNetworkLoadBalancerInterfaceV2Read(d, meta)
+}
+
+// resourceNetworkLoadBalancerInterfaceV2Delete disconnects the interface,
+// because interfaces are deleted only with the load balancer.
+func resourceNetworkLoadBalancerInterfaceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	loadBalancerID := d.Get("load_balancer_id").(string)
+
+	osMutexKV.Lock(loadBalancerID)
+	defer osMutexKV.Unlock(loadBalancerID)
+
+	if _, err := load_balancer_interfaces.Get(networkClient, d.Id()).Extract(); err != nil {
+		return CheckDeleted(d, err, "error getting Load Balancer Interface")
+	}
+
+	updateOpts := expandLoadBalancerInterfaceDisconnectOpts(d.Get("slot_number").(int))
+	if err := updateLoadBalancerInterface(networkClient, d, loadBalancerID, d.Id(), *updateOpts); err != nil {
+		return fmt.Errorf("error disconnecting Load Balancer Interface: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceNetworkLoadBalancerInterfaceV2Change returns the old and new
+// interface in the form of the interfaces of ecl_network_load_balancer_v2.
+func resourceNetworkLoadBalancerInterfaceV2Change(d *schema.ResourceData) (map[string]interface{}, map[string]interface{}) {
+	o := make(map[string]interface{})
+	n := make(map[string]interface{})
+	for _, k := range loadBalancerInterfaceV2Keys {
+		o[k], n[k] = d.GetChange(k)
+	}
+	return o, n
+}
@@ -0,0 +1,322 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedNetworkV2LoadBalancerInterface_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "load_balancers", "/v2.0/load_balancers/5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9", testMockNetworkV2LoadBalancerInterfaceLoadBalancerGet)
+	mc.Register(t, "load_balancer_interfaces", "/v2.0/load_balancer_interfaces/1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52", testMockNetworkV2LoadBalancerInterfacePut1)
+	mc.Register(t, "load_balancer_interfaces", "/v2.0/load_balancer_interfaces/1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52", testMockNetworkV2LoadBalancerInterfaceGetAfterPut1)
+	mc.Register(t, "load_balancer_interfaces", "/v2.0/load_balancer_interfaces/1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52", testMockNetworkV2LoadBalancerInterfacePut2)
+	mc.Register(t, "load_balancer_interfaces", "/v2.0/load_balancer_interfaces/1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52", testMockNetworkV2LoadBalancerInterfaceGetAfterPut2)
+	mc.Register(t, "load_balancer_interfaces", "/v2.0/load_balancer_interfaces/1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52", testMockNetworkV2LoadBalancerInterfacePut3)
+	mc.Register(t, "load_balancer_interfaces", "/v2.0/load_balancer_interfaces/1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52", testMockNetworkV2LoadBalancerInterfaceGetAfterPut3)
+	mc.Register(t, "load_balancer_interfaces", "/v2.0/load_balancer_interfaces", testMockNetworkV2LoadBalancerInterfaceList)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2LoadBalancerInterfaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockNetworkV2LoadBalancerInterfaceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_interface_v2.interface_1", "id", "1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52"),
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_interface_v2.interface_1", "name", "lb_interface_2"),
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_interface_v2.interface_1", "ip_address", "192.168.1.2"),
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_interface_v2.interface_1", "network_id", "7e8f9a0b-1c2d-4e3f-8a4b-5c6d7e8f9a0b"),
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_interface_v2.interface_1", "status", "ACTIVE"),
+				),
+			},
+			resource.TestStep{
+				Config: testMockNetworkV2LoadBalancerInterfaceUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_interface_v2.interface_1", "description", "updated"),
+					resource.TestCheckResourceAttr("data.ecl_network_load_balancer_interface_v2.interface_1", "id", "1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52"),
+					resource.TestCheckResourceAttr("data.ecl_network_load_balancer_interface_v2.interface_1", "description", "updated"),
+					resource.TestCheckResourceAttr("data.ecl_network_load_balancer_interface_v2.interface_1", "type", "user"),
+				),
+			},
+		},
+	})
+}
+
+var testMockNetworkV2LoadBalancerInterfaceBasic = `
+resource "ecl_network_load_balancer_interface_v2" "interface_1" {
+  load_balancer_id = "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9"
+  slot_number = 2
+  name = "lb_interface_2"
+  ip_address = "192.168.1.2"
+  network_id = "7e8f9a0b-1c2d-4e3f-8a4b-5c6d7e8f9a0b"
+}
+`
+
+var testMockNetworkV2LoadBalancerInterfaceUpdate = `
+resource "ecl_network_load_balancer_interface_v2" "interface_1" {
+  load_balancer_id = "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9"
+  slot_number = 2
+  name = "lb_interface_2"
+  description = "updated"
+  ip_address = "192.168.1.2"
+  network_id = "7e8f9a0b-1c2d-4e3f-8a4b-5c6d7e8f9a0b"
+}
+
+data "ecl_network_load_balancer_interface_v2" "interface_1" {
+  load_balancer_id = "${ecl_network_load_balancer_interface_v2.interface_1.load_balancer_id}"
+  slot_number = "${ecl_network_load_balancer_interface_v2.interface_1.slot_number}"
+}
+`
+
+var testMockNetworkV2LoadBalancerInterfaceLoadBalancerGet = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer": {
+            "admin_username": "user-admin",
+            "availability_zone": "zone1_groupa",
+            "default_gateway": null,
+            "description": "",
+            "id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+            "interfaces": [
+              {
+                "id": "1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c51",
+                "ip_address": "",
+                "name": "Interface 1/1",
+                "network_id": "",
+                "slot_number": 1,
+                "status": "DOWN",
+                "type": "user",
+                "virtual_ip_address": null,
+                "virtual_ip_properties": null
+              },
+              {
+                "id": "1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52",
+                "ip_address": "",
+                "name": "Interface 1/2",
+                "network_id": "",
+                "slot_number": 2,
+                "status": "DOWN",
+                "type": "user",
+                "virtual_ip_address": null,
+                "virtual_ip_properties": null
+              }
+            ],
+            "load_balancer_plan_id": "ed306566-646d-4132-a96a-3a984da9a4ca",
+            "name": "lb_1",
+            "status": "ACTIVE",
+            "syslog_servers": null,
+            "tenant_id": "%s",
+            "user_username": "user-read"
+          }
+        }
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerInterfacePut1 = fmt.Sprintf(`
+request:
+    method: PUT
+    body: >
+        {"load_balancer_interface":{"description":"","ip_address":"192.168.1.2","name":"lb_interface_2","network_id":"7e8f9a0b-1c2d-4e3f-8a4b-5c6d7e8f9a0b"}}
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer_interface": {
+            "description": "",
+            "id": "1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52",
+            "ip_address": "192.168.1.2",
+            "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+            "name": "lb_interface_2",
+            "network_id": "7e8f9a0b-1c2d-4e3f-8a4b-5c6d7e8f9a0b",
+            "slot_number": 2,
+            "status": "PENDING_UPDATE",
+            "tenant_id": "%s",
+            "type": "user",
+            "virtual_ip_address": null,
+            "virtual_ip_properties": null
+          }
+        }
+newStatus: Created
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerInterfaceGetAfterPut1 = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer_interface": {
+            "description": "",
+            "id": "1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52",
+            "ip_address": "192.168.1.2",
+            "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+            "name": "lb_interface_2",
+            "network_id": "7e8f9a0b-1c2d-4e3f-8a4b-5c6d7e8f9a0b",
+            "slot_number": 2,
+            "status": "ACTIVE",
+            "tenant_id": "%s",
+            "type": "user",
+            "virtual_ip_address": null,
+            "virtual_ip_properties": null
+          }
+        }
+expectedStatus:
+    - Created
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerInterfacePut2 = fmt.Sprintf(`
+request:
+    method: PUT
+    body: >
+        {"load_balancer_interface":{"description":"updated"}}
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer_interface": {
+            "description": "updated",
+            "id": "1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52",
+            "ip_address": "192.168.1.2",
+            "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+            "name": "lb_interface_2",
+            "network_id": "7e8f9a0b-1c2d-4e3f-8a4b-5c6d7e8f9a0b",
+            "slot_number": 2,
+            "status": "PENDING_UPDATE",
+            "tenant_id": "%s",
+            "type": "user",
+            "virtual_ip_address": null,
+            "virtual_ip_properties": null
+          }
+        }
+expectedStatus:
+    - Created
+newStatus: Updated
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerInterfaceGetAfterPut2 = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer_interface": {
+            "description": "updated",
+            "id": "1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52",
+            "ip_address": "192.168.1.2",
+            "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+            "name": "lb_interface_2",
+            "network_id": "7e8f9a0b-1c2d-4e3f-8a4b-5c6d7e8f9a0b",
+            "slot_number": 2,
+            "status": "ACTIVE",
+            "tenant_id": "%s",
+            "type": "user",
+            "virtual_ip_address": null,
+            "virtual_ip_properties": null
+          }
+        }
+expectedStatus:
+    - Updated
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerInterfaceList = fmt.Sprintf(`
+request:
+    method: GET
+    query:
+        load_balancer_id:
+            - 5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9
+        slot_number:
+            - "2"
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer_interfaces": [
+            {
+              "description": "updated",
+              "id": "1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52",
+              "ip_address": "192.168.1.2",
+              "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+              "name": "lb_interface_2",
+              "network_id": "7e8f9a0b-1c2d-4e3f-8a4b-5c6d7e8f9a0b",
+              "slot_number": 2,
+              "status": "ACTIVE",
+              "tenant_id": "%s",
+              "type": "user",
+              "virtual_ip_address": null,
+              "virtual_ip_properties": null
+            }
+          ]
+        }
+expectedStatus:
+    - Created
+    - Updated
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerInterfacePut3 = fmt.Sprintf(`
+request:
+    method: PUT
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer_interface": {
+            "description": "",
+            "id": "1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52",
+            "ip_address": "192.168.1.2",
+            "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+            "name": "Interface 1/2",
+            "network_id": null,
+            "slot_number": 2,
+            "status": "PENDING_UPDATE",
+            "tenant_id": "%s",
+            "type": "user",
+            "virtual_ip_address": null,
+            "virtual_ip_properties": null
+          }
+        }
+expectedStatus:
+    - Updated
+newStatus: Deleted
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerInterfaceGetAfterPut3 = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer_interface": {
+            "description": "",
+            "id": "1a2b3c4d-0e1f-4a5b-8c6d-7e8f9a0b1c52",
+            "ip_address": "192.168.1.2",
+            "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+            "name": "Interface 1/2",
+            "network_id": null,
+            "slot_number": 2,
+            "status": "DOWN",
+            "tenant_id": "%s",
+            "type": "user",
+            "virtual_ip_address": null,
+            "virtual_ip_properties": null
+          }
+        }
+expectedStatus:
+    - Deleted
+`, OS_TENANT_ID)
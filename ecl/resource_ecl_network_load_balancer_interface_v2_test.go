@@ -0,0 +1,176 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/load_balancer_interfaces"
+)
+
+func TestAccNetworkV2LoadBalancerInterface_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckNetworkV2LoadBalancerSyslogServerDestroy,
+			testAccCheckNetworkV2LoadBalancerDestroy,
+		),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkV2LoadBalancerInterfaceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ecl_network_load_balancer_interface_v2.interface_1", "name", "lb_test1_interface1"),
+					resource.TestCheckResourceAttr(
+						"ecl_network_load_balancer_interface_v2.interface_1", "ip_address", "192.168.151.11"),
+					resource.TestCheckResourceAttrPair(
+						"ecl_network_load_balancer_interface_v2.interface_1", "network_id",
+						"ecl_network_network_v2.network_1", "id"),
+					resource.TestCheckResourceAttr(
+						"ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "ip_address", "192.168.151.21"),
+					resource.TestCheckResourceAttr(
+						"ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "log_level", "ALERT|CRITICAL|EMERGENCY"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkV2LoadBalancerInterfaceUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ecl_network_load_balancer_interface_v2.interface_1", "description", "lb_test1_interface1_description"),
+					resource.TestCheckResourceAttr(
+						"ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "description", "lb_test1_syslog1_description"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_network_load_balancer_interface_v2.interface_1", "id",
+						"ecl_network_load_balancer_interface_v2.interface_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "id",
+						"ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ecl_network_load_balancer_interface_v2.interface_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				ResourceName:      "ecl_network_load_balancer_syslog_server_v2.syslog_server_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckNetworkV2LoadBalancerInterfaceDestroy checks the interfaces are
+// disconnected, because they are deleted only with the load balancer.
+func testAccCheckNetworkV2LoadBalancerInterfaceDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkClient, err := config.networkV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ecl_network_load_balancer_interface_v2" {
+			continue
+		}
+
+		lbInterface, err := load_balancer_interfaces.Get(networkClient, rs.Primary.ID).Extract()
+		if err != nil {
+			continue
+		}
+
+		if lbInterface.NetworkID != nil && *lbInterface.NetworkID != "" {
+			return fmt.Errorf("load balancer interface is still connected")
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkV2LoadBalancerInterfaceLoadBalancer = `
+resource "ecl_network_load_balancer_v2" "lb_test1" {
+  name = "lb_test1"
+  availability_zone = "zone1_groupa"
+  load_balancer_plan_id = "${data.ecl_network_load_balancer_plan_v2.lb_plan1.id}"
+
+  lifecycle {
+    ignore_changes = ["syslog_servers"]
+  }
+}
+`
+
+var testAccNetworkV2LoadBalancerInterfaceBasic = fmt.Sprintf(`
+%s
+
+%s
+
+%s
+
+resource "ecl_network_load_balancer_interface_v2" "interface_1" {
+  load_balancer_id = "${ecl_network_load_balancer_v2.lb_test1.id}"
+  slot_number = 1
+  name = "lb_test1_interface1"
+  ip_address = "192.168.151.11"
+  network_id = "${ecl_network_network_v2.network_1.id}"
+  depends_on = ["ecl_network_subnet_v2.subnet_1_1"]
+}
+
+resource "ecl_network_load_balancer_syslog_server_v2" "syslog_server_1" {
+  load_balancer_id = "${ecl_network_load_balancer_interface_v2.interface_1.load_balancer_id}"
+  name = "lb_test1_syslog1"
+  ip_address = "192.168.151.21"
+  log_level = "ALERT|CRITICAL|EMERGENCY"
+}
+`,
+	testAccNetworkV2LoadBalancerPlan4IF,
+	testAccNetworkV2LoadBalancerSingleNetworkAndSubnetPair1,
+	testAccNetworkV2LoadBalancerInterfaceLoadBalancer,
+)
+
+var testAccNetworkV2LoadBalancerInterfaceUpdate = fmt.Sprintf(`
+%s
+
+%s
+
+%s
+
+resource "ecl_network_load_balancer_interface_v2" "interface_1" {
+  load_balancer_id = "${ecl_network_load_balancer_v2.lb_test1.id}"
+  slot_number = 1
+  name = "lb_test1_interface1"
+  description = "lb_test1_interface1_description"
+  ip_address = "192.168.151.11"
+  network_id = "${ecl_network_network_v2.network_1.id}"
+  depends_on = ["ecl_network_subnet_v2.subnet_1_1"]
+}
+
+resource "ecl_network_load_balancer_syslog_server_v2" "syslog_server_1" {
+  load_balancer_id = "${ecl_network_load_balancer_interface_v2.interface_1.load_balancer_id}"
+  name = "lb_test1_syslog1"
+  description = "lb_test1_syslog1_description"
+  ip_address = "192.168.151.21"
+  log_level = "ALERT|CRITICAL|EMERGENCY"
+}
+
+data "ecl_network_load_balancer_interface_v2" "interface_1" {
+  load_balancer_id = "${ecl_network_load_balancer_interface_v2.interface_1.load_balancer_id}"
+  slot_number = "${ecl_network_load_balancer_interface_v2.interface_1.slot_number}"
+}
+
+data "ecl_network_load_balancer_syslog_server_v2" "syslog_server_1" {
+  load_balancer_id = "${ecl_network_load_balancer_syslog_server_v2.syslog_server_1.load_balancer_id}"
+  name = "${ecl_network_load_balancer_syslog_server_v2.syslog_server_1.name}"
+}
+`,
+	testAccNetworkV2LoadBalancerPlan4IF,
+	testAccNetworkV2LoadBalancerSingleNetworkAndSubnetPair1,
+	testAccNetworkV2LoadBalancerInterfaceLoadBalancer,
+)
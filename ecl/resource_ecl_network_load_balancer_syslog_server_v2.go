@@ -0,0 +1,292 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/load_balancer_syslog_servers"
+)
+
+var loadBalancerSyslogServerV2Keys = []string{
+	"acl_logging",
+	"appflow_logging",
+	"date_format",
+	"description",
+	"ip_address",
+	"log_facility",
+	"log_level",
+	"name",
+	"port_number",
+	"priority",
+	"tcp_logging",
+	"tenant_id",
+	"time_zone",
+	"transport_type",
+	"user_configurable_log_messages",
+}
+
+func resourceNetworkLoadBalancerSyslogServerV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkLoadBalancerSyslogServerV2Create,
+		Read:   resourceNetworkLoadBalancerSyslogServerV2Read,
+		Update: resourceNetworkLoadBalancerSyslogServerV2Update,
+		Delete: resourceNetworkLoadBalancerSyslogServerV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"acl_logging": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ENABLED", "DISABLED",
+				}, false),
+			},
+
+			"appflow_logging": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ENABLED", "DISABLED",
+				}, false),
+			},
+
+			"date_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DDMMYYYY", "MMDDYYYY", "YYYYMMDD",
+				}, false),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
+
+			"log_facility": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"LOCAL0", "LOCAL1", "LOCAL2", "LOCAL3", "LOCAL4", "LOCAL5", "LOCAL6", "LOCAL7",
+				}, false),
+			},
+
+			"log_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"port_number": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tcp_logging": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"NONE", "ALL",
+				}, false),
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"time_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GMT_TIME", "LOCAL_TIME",
+				}, false),
+			},
+
+			"transport_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"UDP",
+				}, false),
+			},
+
+			"user_configurable_log_messages": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"YES", "NO",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceNetworkLoadBalancerSyslogServerV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	loadBalancerID := d.Get("load_balancer_id").(string)
+
+	osMutexKV.Lock(loadBalancerID)
+	defer osMutexKV.Unlock(loadBalancerID)
+
+	_, n := resourceNetworkLoadBalancerSyslogServerV2Change(d)
+	createOpts := expandLoadBalancerSyslogServerCreateOpts(n, loadBalancerID)
+	id, err := createLoadBalancerSyslogServer(networkClient, d, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating Load Balancer Syslog Server: %w", err)
+	}
+
+	d.SetId(id)
+
+	return resourceNetworkLoadBalancerSyslogServerV2Read(d, meta)
+}
+
+func resourceNetworkLoadBalancerSyslogServerV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	syslogServer, err := load_balancer_syslog_servers.Get(networkClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "error getting Load Balancer Syslog Server")
+	}
+
+	log.Printf("[DEBUG] Retrieved Load Balancer Syslog Server %s: %+v", d.Id(), syslogServer)
+
+	d.Set("load_balancer_id", syslogServer.LoadBalancerID)
+	d.Set("acl_logging", syslogServer.AclLogging)
+	d.Set("appflow_logging", syslogServer.AppflowLogging)
+	d.Set("date_format", syslogServer.DateFormat)
+	d.Set("description", syslogServer.Description)
+	d.Set("ip_address", syslogServer.IPAddress)
+	d.Set("log_facility", syslogServer.LogFacility)
+	d.Set("log_level", syslogServer.LogLevel)
+	d.Set("name", syslogServer.Name)
+	d.Set("port_number", syslogServer.PortNumber)
+	d.Set("priority", syslogServer.Priority)
+	d.Set("status", syslogServer.Status)
+	d.Set("tcp_logging", syslogServer.TcpLogging)
+	d.Set("tenant_id", syslogServer.TenantID)
+	d.Set("time_zone", syslogServer.TimeZone)
+	d.Set("transport_type", syslogServer.TransportType)
+	d.Set("user_configurable_log_messages", syslogServer.UserConfigurableLogMessages)
+
+	return nil
+}
+
+func resourceNetworkLoadBalancerSyslogServerV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	loadBalancerID := d.Get("load_balancer_id").(string)
+
+	osMutexKV.Lock(loadBalancerID)
+	defer osMutexKV.Unlock(loadBalancerID)
+
+	o, n := resourceNetworkLoadBalancerSyslogServerV2Change(d)
+	if updateOpts := expandLoadBalancerSyslogServerChanges(o, n); updateOpts != nil {
+		if err := updateLoadBalancerSyslogServer(networkClient, d, d.Id(), *updateOpts); err != nil {
+			return fmt.Errorf("error updating Load Balancer Syslog Server: %w", err)
+		}
+	}
+
+	return resourceNetworkLoadBalancerSyslogServerV2Read(d, meta)
+}
+
+func resourceNetworkLoadBalancerSyslogServerV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	loadBalancerID := d.Get("load_balancer_id").(string)
+
+	osMutexKV.Lock(loadBalancerID)
+	defer osMutexKV.Unlock(loadBalancerID)
+
+	if err := deleteLoadBalancerSyslogServer(d, networkClient, d.Id()); err != nil {
+		return fmt.Errorf("error deleting Load Balancer Syslog Server: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceNetworkLoadBalancerSyslogServerV2Change returns the old and new
+// syslog server in the form of the syslog_servers of
+// ecl_network_load_balancer_v2.
+func resourceNetworkLoadBalancerSyslogServerV2Change(d *schema.ResourceData) (map[string]interface{}, map[string]interface{}) {
+	o := make(map[string]interface{})
+	n := make(map[string]interface{})
+	for _, k := range loadBalancerSyslogServerV2Keys {
+		o[k], n[k] = d.GetChange(k)
+	}
+	return o, n
+}
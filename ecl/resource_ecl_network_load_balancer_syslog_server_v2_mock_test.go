@@ -0,0 +1,264 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedNetworkV2LoadBalancerSyslogServer_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "load_balancer_syslog_servers", "/v2.0/load_balancer_syslog_servers", testMockNetworkV2LoadBalancerSyslogServerResourcePost)
+	mc.Register(t, "load_balancer_syslog_servers", "/v2.0/load_balancer_syslog_servers", testMockNetworkV2LoadBalancerSyslogServerResourceList)
+	mc.Register(t, "load_balancer_syslog_servers", "/v2.0/load_balancer_syslog_servers/4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f", testMockNetworkV2LoadBalancerSyslogServerResourceGetAfterCreate)
+	mc.Register(t, "load_balancer_syslog_servers", "/v2.0/load_balancer_syslog_servers/4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f", testMockNetworkV2LoadBalancerSyslogServerResourcePut)
+	mc.Register(t, "load_balancer_syslog_servers", "/v2.0/load_balancer_syslog_servers/4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f", testMockNetworkV2LoadBalancerSyslogServerResourceGetAfterUpdate)
+	mc.Register(t, "load_balancer_syslog_servers", "/v2.0/load_balancer_syslog_servers/4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f", testMockNetworkV2LoadBalancerSyslogServerResourceDelete)
+	mc.Register(t, "load_balancer_syslog_servers", "/v2.0/load_balancer_syslog_servers/4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f", testMockNetworkV2LoadBalancerSyslogServerResourceGetDeleted)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2LoadBalancerSyslogServerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockNetworkV2LoadBalancerSyslogServerResourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "id", "4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f"),
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "name", "lb_syslog_1"),
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "ip_address", "192.168.1.21"),
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "port_number", "514"),
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "status", "ACTIVE"),
+				),
+			},
+			resource.TestStep{
+				Config: testMockNetworkV2LoadBalancerSyslogServerResourceUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "description", "updated"),
+					resource.TestCheckResourceAttr("data.ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "id", "4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f"),
+					resource.TestCheckResourceAttr("data.ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "description", "updated"),
+					resource.TestCheckResourceAttr("data.ecl_network_load_balancer_syslog_server_v2.syslog_server_1", "log_facility", "LOCAL0"),
+				),
+			},
+		},
+	})
+}
+
+var testMockNetworkV2LoadBalancerSyslogServerResourceBasic = `
+resource "ecl_network_load_balancer_syslog_server_v2" "syslog_server_1" {
+  load_balancer_id = "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9"
+  name = "lb_syslog_1"
+  ip_address = "192.168.1.21"
+}
+`
+
+var testMockNetworkV2LoadBalancerSyslogServerResourceUpdate = `
+resource "ecl_network_load_balancer_syslog_server_v2" "syslog_server_1" {
+  load_balancer_id = "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9"
+  name = "lb_syslog_1"
+  description = "updated"
+  ip_address = "192.168.1.21"
+}
+
+data "ecl_network_load_balancer_syslog_server_v2" "syslog_server_1" {
+  load_balancer_id = "${ecl_network_load_balancer_syslog_server_v2.syslog_server_1.load_balancer_id}"
+  name = "${ecl_network_load_balancer_syslog_server_v2.syslog_server_1.name}"
+}
+`
+
+var testMockNetworkV2LoadBalancerSyslogServerResourcePost = fmt.Sprintf(`
+request:
+    method: POST
+response:
+    code: 201
+    body: >
+        {
+          "load_balancer_syslog_server": {
+            "acl_logging": "DISABLED",
+            "appflow_logging": "DISABLED",
+            "date_format": "MMDDYYYY",
+            "description": "",
+            "id": "4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f",
+            "ip_address": "192.168.1.21",
+            "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+            "log_facility": "LOCAL0",
+            "log_level": "ALERT|CRITICAL|EMERGENCY",
+            "name": "lb_syslog_1",
+            "port_number": 514,
+            "priority": 0,
+            "status": "PENDING_CREATE",
+            "tcp_logging": "NONE",
+            "tenant_id": "%s",
+            "time_zone": "LOCAL_TIME",
+            "transport_type": "UDP",
+            "user_configurable_log_messages": "NO"
+          }
+        }
+newStatus: Created
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerSyslogServerResourceGetAfterCreate = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer_syslog_server": {
+            "acl_logging": "DISABLED",
+            "appflow_logging": "DISABLED",
+            "date_format": "MMDDYYYY",
+            "description": "",
+            "id": "4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f",
+            "ip_address": "192.168.1.21",
+            "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+            "log_facility": "LOCAL0",
+            "log_level": "ALERT|CRITICAL|EMERGENCY",
+            "name": "lb_syslog_1",
+            "port_number": 514,
+            "priority": 0,
+            "status": "ACTIVE",
+            "tcp_logging": "NONE",
+            "tenant_id": "%s",
+            "time_zone": "LOCAL_TIME",
+            "transport_type": "UDP",
+            "user_configurable_log_messages": "NO"
+          }
+        }
+expectedStatus:
+    - Created
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerSyslogServerResourcePut = fmt.Sprintf(`
+request:
+    method: PUT
+    body: >
+        {"load_balancer_syslog_server":{"description":"updated"}}
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer_syslog_server": {
+            "acl_logging": "DISABLED",
+            "appflow_logging": "DISABLED",
+            "date_format": "MMDDYYYY",
+            "description": "updated",
+            "id": "4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f",
+            "ip_address": "192.168.1.21",
+            "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+            "log_facility": "LOCAL0",
+            "log_level": "ALERT|CRITICAL|EMERGENCY",
+            "name": "lb_syslog_1",
+            "port_number": 514,
+            "priority": 0,
+            "status": "PENDING_UPDATE",
+            "tcp_logging": "NONE",
+            "tenant_id": "%s",
+            "time_zone": "LOCAL_TIME",
+            "transport_type": "UDP",
+            "user_configurable_log_messages": "NO"
+          }
+        }
+expectedStatus:
+    - Created
+newStatus: Updated
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerSyslogServerResourceGetAfterUpdate = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer_syslog_server": {
+            "acl_logging": "DISABLED",
+            "appflow_logging": "DISABLED",
+            "date_format": "MMDDYYYY",
+            "description": "updated",
+            "id": "4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f",
+            "ip_address": "192.168.1.21",
+            "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+            "log_facility": "LOCAL0",
+            "log_level": "ALERT|CRITICAL|EMERGENCY",
+            "name": "lb_syslog_1",
+            "port_number": 514,
+            "priority": 0,
+            "status": "ACTIVE",
+            "tcp_logging": "NONE",
+            "tenant_id": "%s",
+            "time_zone": "LOCAL_TIME",
+            "transport_type": "UDP",
+            "user_configurable_log_messages": "NO"
+          }
+        }
+expectedStatus:
+    - Updated
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerSyslogServerResourceList = fmt.Sprintf(`
+request:
+    method: GET
+    query:
+        load_balancer_id:
+            - 5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9
+        name:
+            - lb_syslog_1
+response:
+    code: 200
+    body: >
+        {
+          "load_balancer_syslog_servers": [
+            {
+              "acl_logging": "DISABLED",
+              "appflow_logging": "DISABLED",
+              "date_format": "MMDDYYYY",
+              "description": "updated",
+              "id": "4c5d6e7f-8a9b-4c0d-9e1f-2a3b4c5d6e7f",
+              "ip_address": "192.168.1.21",
+              "load_balancer_id": "5f3b2a1c-9d8e-4f7a-b6c5-d4e3f2a1b0c9",
+              "log_facility": "LOCAL0",
+              "log_level": "ALERT|CRITICAL|EMERGENCY",
+              "name": "lb_syslog_1",
+              "port_number": 514,
+              "priority": 0,
+              "status": "ACTIVE",
+              "tcp_logging": "NONE",
+              "tenant_id": "%s",
+              "time_zone": "LOCAL_TIME",
+              "transport_type": "UDP",
+              "user_configurable_log_messages": "NO"
+            }
+          ]
+        }
+expectedStatus:
+    - Created
+    - Updated
+`, OS_TENANT_ID)
+
+var testMockNetworkV2LoadBalancerSyslogServerResourceDelete = `
+request:
+    method: DELETE
+response:
+    code: 204
+expectedStatus:
+    - Updated
+newStatus: Deleted
+`
+
+var testMockNetworkV2LoadBalancerSyslogServerResourceGetDeleted = `
+request:
+    method: GET
+response:
+    code: 404
+expectedStatus:
+    - Deleted
+`
@@ -0,0 +1,29 @@
+package ecl
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/load_balancer_syslog_servers"
+)
+
+func testAccCheckNetworkV2LoadBalancerSyslogServerDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkClient, err := config.networkV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating ECL network client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ecl_network_load_balancer_syslog_server_v2" {
+			continue
+		}
+
+		if _, err := load_balancer_syslog_servers.Get(networkClient, rs.Primary.ID).Extract(); err == nil {
+			return fmt.Errorf("load balancer syslog server still exists")
+		}
+	}
+
+	return nil
+}
@@ -315,15 +315,14 @@ func resourceNetworkLoadBalancerV2() *schema.Resource {
 }
 
 func resourceNetworkLoadBalancerV2CustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
-	o, n := d.GetChange("interfaces")
-	if len(n.([]interface{})) < 1 {
-		return fmt.Errorf("at least 1 interface must be set")
-	}
-
+	// Interfaces may be omitted to be managed by
+	// ecl_network_load_balancer_interface_v2 resources.
 	if !d.HasChange("interfaces") {
 		return nil
 	}
 
+	o, n := d.GetChange("interfaces")
+
 	if len(o.([]interface{})) == 0 {
 		return nil
 	}
@@ -441,7 +440,7 @@ func resourceNetworkLoadBalancerV2Create(d *schema.ResourceData, meta interface{
 		updateInterfaceOpts := expandLoadBalancerInterfaceInitialUpdateOpts(interfaceConfig)
 
 		// .. update, call Show interface API and wait for active
-		if err := updateLoadBalancerInterface(networkClient, d, loadBalancer.Interfaces[configIndex].ID, *updateInterfaceOpts); err != nil {
+		if err := updateLoadBalancerInterface(networkClient, d, d.Id(), loadBalancer.Interfaces[configIndex].ID, *updateInterfaceOpts); err != nil {
 			return fmt.Errorf("error updating Load Balancer Interface in creating LB: %w", err)
 		}
 	}
@@ -462,7 +461,7 @@ func resourceNetworkLoadBalancerV2Create(d *schema.ResourceData, meta interface{
 		syslogConfig := v.(map[string]interface{})
 
 		createSyslogOpts := expandLoadBalancerSyslogServerCreateOpts(syslogConfig, loadBalancer.ID)
-		if err := createLoadBalancerSyslogServer(networkClient, d, createSyslogOpts); err != nil {
+		if _, err := createLoadBalancerSyslogServer(networkClient, d, createSyslogOpts); err != nil {
 			return fmt.Errorf("error creating Load Balancer Syslog Server: %w", err)
 		}
 	}
@@ -610,20 +609,12 @@ func resourceNetworkLoadBalancerV2Update(d *schema.ResourceData, meta interface{
 			}
 
 			if !found && !(state["status"].(string) == "DOWN" && state["name"].(string) == fmt.Sprintf("Interface 1/%d", slotNumber) && state["description"].(string) == "") {
-				updateInterfaceOpts = &load_balancer_interfaces.UpdateOpts{}
-				virtualIPAddress := interface{}(nil)
-				updateInterfaceOpts.VirtualIPAddress = &virtualIPAddress
-				networkID := interface{}(nil)
-				updateInterfaceOpts.NetworkID = &networkID
-				name := fmt.Sprintf("Interface 1/%d", slotNumber)
-				updateInterfaceOpts.Name = &name
-				description := ""
-				updateInterfaceOpts.Description = &description
+				updateInterfaceOpts = expandLoadBalancerInterfaceDisconnectOpts(slotNumber)
 			}
 
 			if updateInterfaceOpts != nil {
 				// .. update, call Show interface API and wait for active
-				if err := updateLoadBalancerInterface(networkClient, d, state["id"].(string), *updateInterfaceOpts); err != nil {
+				if err := updateLoadBalancerInterface(networkClient, d, d.Id(), state["id"].(string), *updateInterfaceOpts); err != nil {
 					return fmt.Errorf("error while updating Load Balancer Interface: %w", err)
 				}
 			}
@@ -653,7 +644,7 @@ func resourceNetworkLoadBalancerV2Update(d *schema.ResourceData, meta interface{
 				for _, e := range loadBalancer.Interfaces {
 					if e.SlotNumber == slotNumber {
 						// .. update, call Show interface API and wait for active
-						if err := updateLoadBalancerInterface(networkClient, d, e.ID, *updateInterfaceOpts); err != nil {
+						if err := updateLoadBalancerInterface(networkClient, d, d.Id(), e.ID, *updateInterfaceOpts); err != nil {
 							return fmt.Errorf("error while updating newly configured Load Balancer Interface: %w", err)
 						}
 						break
@@ -670,7 +661,7 @@ func resourceNetworkLoadBalancerV2Update(d *schema.ResourceData, meta interface{
 			syslogConfig := v.(map[string]interface{})
 
 			createSyslogOpts := expandLoadBalancerSyslogServerCreateOpts(syslogConfig, d.Id())
-			if err := createLoadBalancerSyslogServer(networkClient, d, createSyslogOpts); err != nil {
+			if _, err := createLoadBalancerSyslogServer(networkClient, d, createSyslogOpts); err != nil {
 				return fmt.Errorf("error creating Load Balancer Syslog Server: %w", err)
 			}
 		}
@@ -731,7 +722,7 @@ func resourceNetworkLoadBalancerV2Update(d *schema.ResourceData, meta interface{
 
 			if !found {
 				createSyslogOpts := expandLoadBalancerSyslogServerCreateOpts(config, d.Id())
-				if err := createLoadBalancerSyslogServer(networkClient, d, createSyslogOpts); err != nil {
+				if _, err := createLoadBalancerSyslogServer(networkClient, d, createSyslogOpts); err != nil {
 					return fmt.Errorf("error creating Load Balancer Syslog Server: %w", err)
 				}
 			}
@@ -876,11 +867,11 @@ func waitForLoadBalancerSyslogServerDelete(networkClient *eclcloud.ServiceClient
 	}
 }
 
-func createLoadBalancerSyslogServer(networkClient *eclcloud.ServiceClient, d *schema.ResourceData, createSyslogOpts load_balancer_syslog_servers.CreateOpts) error {
+func createLoadBalancerSyslogServer(networkClient *eclcloud.ServiceClient, d *schema.ResourceData, createSyslogOpts load_balancer_syslog_servers.CreateOpts) (string, error) {
 	log.Printf("[DEBUG] Create Load Balancer Syslog Server Options: %#v", createSyslogOpts)
 	syslogServer, err := load_balancer_syslog_servers.Create(networkClient, createSyslogOpts).Extract()
 	if err != nil {
-		return fmt.Errorf("error creating ECL load balancer syslog server: %w", err)
+		return "", fmt.Errorf("error creating ECL load balancer syslog server: %w", err)
 	}
 
 	log.Printf("[INFO] Load Balancer Syslog Server ID: %s", syslogServer.ID)
@@ -897,11 +888,11 @@ func createLoadBalancerSyslogServer(networkClient *eclcloud.ServiceClient, d *sc
 	}
 
 	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf(
+		return "", fmt.Errorf(
 			"error waiting for load balancer syslog server (%s) to become ACTIVE: %w",
 			syslogServer.ID, err)
 	}
-	return nil
+	return syslogServer.ID, nil
 }
 
 func flattenLoadBalancerInterfaces(in []load_balancer_interfaces.LoadBalancerInterface) []interface{} {
@@ -1007,7 +998,7 @@ func updateLoadBalancer(networkClient *eclcloud.ServiceClient, d *schema.Resourc
 	return nil
 }
 
-func updateLoadBalancerInterface(networkClient *eclcloud.ServiceClient, d *schema.ResourceData, id string, updateOpts load_balancer_interfaces.UpdateOpts) error {
+func updateLoadBalancerInterface(networkClient *eclcloud.ServiceClient, d *schema.ResourceData, loadBalancerID string, id string, updateOpts load_balancer_interfaces.UpdateOpts) error {
 	log.Printf("[DEBUG] Updating Load Balancer Interface %s with options: %+v", id, updateOpts)
 	if _, err := load_balancer_interfaces.Update(networkClient, id, updateOpts).Extract(); err != nil {
 		return fmt.Errorf(
@@ -1035,7 +1026,7 @@ func updateLoadBalancerInterface(networkClient *eclcloud.ServiceClient, d *schem
 	stateConf = &resource.StateChangeConf{
 		Pending:      []string{"PENDING_UPDATE"},
 		Target:       []string{"ACTIVE"},
-		Refresh:      waitForLoadBalancerComplete(networkClient, d.Id()),
+		Refresh:      waitForLoadBalancerComplete(networkClient, loadBalancerID),
 		Timeout:      d.Timeout(schema.TimeoutUpdate),
 		Delay:        5 * time.Second,
 		PollInterval: loadBalancerPollInterval,
@@ -1045,7 +1036,7 @@ func updateLoadBalancerInterface(networkClient *eclcloud.ServiceClient, d *schem
 	if _, err := stateConf.WaitForState(); err != nil {
 		return fmt.Errorf(
 			"error waiting for Load Balancer (%s) to become ACTIVE(after interface update): %w",
-			d.Id(), err)
+			loadBalancerID, err)
 	}
 
 	return nil
@@ -1148,6 +1139,26 @@ func expandLoadBalancerInterfaceInitialUpdateOpts(new map[string]interface{}) *l
 	return &updateInterfaceOpts
 }
 
+// expandLoadBalancerInterfaceDisconnectOpts returns the options to disconnect
+// the interface and restore its default name and description.
+func expandLoadBalancerInterfaceDisconnectOpts(slotNumber int) *load_balancer_interfaces.UpdateOpts {
+	updateOpts := load_balancer_interfaces.UpdateOpts{}
+
+	virtualIPAddress := interface{}(nil)
+	updateOpts.VirtualIPAddress = &virtualIPAddress
+
+	networkID := interface{}(nil)
+	updateOpts.NetworkID = &networkID
+
+	name := fmt.Sprintf("Interface 1/%d", slotNumber)
+	updateOpts.Name = &name
+
+	description := ""
+	updateOpts.Description = &description
+
+	return &updateOpts
+}
+
 func expandLoadBalancerInterfaceChanges(old map[string]interface{}, new map[string]interface{}) *load_balancer_interfaces.UpdateOpts {
 	var updateOpts *load_balancer_interfaces.UpdateOpts
 
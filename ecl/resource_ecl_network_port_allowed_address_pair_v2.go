@@ -0,0 +1,216 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/ports"
+)
+
+func resourceNetworkPortAllowedAddressPairV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkPortAllowedAddressPairV2Create,
+		Read:   resourceNetworkPortAllowedAddressPairV2Read,
+		Delete: resourceNetworkPortAllowedAddressPairV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:       schema.TypeString,
+				Optional:   true,
+				Computed:   true,
+				ForceNew:   true,
+				Deprecated: "This attribute is not used to set up the resource.",
+			},
+			"port_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mac_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkPortAllowedAddressPairV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	portID := d.Get("port_id").(string)
+	ipAddress := d.Get("ip_address").(string)
+
+	// Lock the port so that pairs of other resources are not lost
+	osMutexKV.Lock(portID)
+	defer osMutexKV.Unlock(portID)
+
+	port, err := ports.Get(networkClient, portID).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve ECL port %s: %s", portID, err)
+	}
+
+	// The pair uses the MAC address of the port when none is given.
+	macAddress := d.Get("mac_address").(string)
+	if macAddress == "" {
+		macAddress = port.MACAddress
+	}
+
+	if networkPortAllowedAddressPairV2Find(port, ipAddress, macAddress) >= 0 {
+		return fmt.Errorf("Allowed address pair %s/%s already exists on ECL port %s", ipAddress, macAddress, portID)
+	}
+
+	pairs := append(port.AllowedAddressPairs, ports.AddressPair{
+		IPAddress:  ipAddress,
+		MACAddress: macAddress,
+	})
+	updateOpts := ports.UpdateOpts{
+		AllowedAddressPairs: &pairs,
+	}
+
+	log.Printf("[DEBUG] Adding allowed address pair %s/%s to port %s", ipAddress, macAddress, portID)
+	_, err = ports.Update(networkClient, portID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error adding allowed address pair to ECL port %s: %s", portID, err)
+	}
+
+	d.SetId(strings.Join([]string{portID, ipAddress, macAddress}, "/"))
+
+	return resourceNetworkPortAllowedAddressPairV2Read(d, meta)
+}
+
+func resourceNetworkPortAllowedAddressPairV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	portID, ipAddress, macAddress, err := networkPortAllowedAddressPairV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	port, err := ports.Get(networkClient, portID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "port")
+	}
+
+	i := networkPortAllowedAddressPairV2Find(port, ipAddress, macAddress)
+	if i < 0 {
+		log.Printf("[DEBUG] Allowed address pair %s not found on port %s", d.Id(), portID)
+		d.SetId("")
+		return nil
+	}
+
+	pair := port.AllowedAddressPairs[i]
+
+	// An imported ID may omit the MAC address.
+	d.SetId(strings.Join([]string{portID, pair.IPAddress, pair.MACAddress}, "/"))
+
+	d.Set("port_id", portID)
+	d.Set("ip_address", pair.IPAddress)
+	d.Set("mac_address", pair.MACAddress)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkPortAllowedAddressPairV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	portID, ipAddress, macAddress, err := networkPortAllowedAddressPairV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Lock the port so that pairs of other resources are not lost
+	osMutexKV.Lock(portID)
+	defer osMutexKV.Unlock(portID)
+
+	port, err := ports.Get(networkClient, portID).Extract()
+	if err != nil {
+		if _, ok := err.(eclcloud.ErrDefault404); ok {
+			// The pairs are gone with the port
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Unable to retrieve ECL port %s: %s", portID, err)
+	}
+
+	i := networkPortAllowedAddressPairV2Find(port, ipAddress, macAddress)
+	if i < 0 {
+		d.SetId("")
+		return nil
+	}
+
+	pairs := append([]ports.AddressPair{}, port.AllowedAddressPairs[:i]...)
+	pairs = append(pairs, port.AllowedAddressPairs[i+1:]...)
+	updateOpts := ports.UpdateOpts{
+		AllowedAddressPairs: &pairs,
+	}
+
+	log.Printf("[DEBUG] Removing allowed address pair %s/%s from port %s", ipAddress, macAddress, portID)
+	_, err = ports.Update(networkClient, portID, updateOpts).Extract()
+	if err != nil {
+		if _, ok := err.(eclcloud.ErrDefault404); ok {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error removing allowed address pair from ECL port %s: %s", portID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// networkPortAllowedAddressPairV2ParseID splits the ID of an allowed address
+// pair, <port_id>/<ip_address>/<mac_address>. The MAC address may be omitted
+// when importing, then the pair is matched by its IP address only.
+func networkPortAllowedAddressPairV2ParseID(id string) (string, string, string, error) {
+	// The IP address may be a CIDR, which contains a slash itself.
+	parts := strings.Split(id, "/")
+	switch {
+	case len(parts) >= 3 && strings.Contains(parts[len(parts)-1], ":"):
+		return parts[0], strings.Join(parts[1:len(parts)-1], "/"), parts[len(parts)-1], nil
+	case len(parts) >= 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], strings.Join(parts[1:], "/"), "", nil
+	}
+
+	return "", "", "", fmt.Errorf("Invalid format of allowed address pair ID %q, expected <port_id>/<ip_address>[/<mac_address>]", id)
+}
+
+// networkPortAllowedAddressPairV2Find returns the index of the allowed
+// address pair of the port, or -1. An empty MAC address matches any MAC.
+func networkPortAllowedAddressPairV2Find(port *ports.Port, ipAddress, macAddress string) int {
+	for i, pair := range port.AllowedAddressPairs {
+		if pair.IPAddress != ipAddress {
+			continue
+		}
+		if macAddress == "" || strings.EqualFold(pair.MACAddress, macAddress) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,200 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedNetworkV2PortAllowedAddressPair_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "ports", "/v2.0/ports/6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01", testMockNetworkV2PortAllowedAddressPairGetAfterAdd)
+	mc.Register(t, "ports", "/v2.0/ports/6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01", testMockNetworkV2PortAllowedAddressPairRemove)
+	mc.Register(t, "ports", "/v2.0/ports/6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01", testMockNetworkV2PortAllowedAddressPairAdd)
+	mc.Register(t, "ports", "/v2.0/ports/6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01", testMockNetworkV2PortAllowedAddressPairGet)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2PortAllowedAddressPairDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockNetworkV2PortAllowedAddressPairBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ecl_network_port_allowed_address_pair_v2.pair_1", "id", "6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01/192.168.1.100/fa:16:3e:00:00:01"),
+					resource.TestCheckResourceAttr("ecl_network_port_allowed_address_pair_v2.pair_1", "ip_address", "192.168.1.100"),
+					resource.TestCheckResourceAttr("ecl_network_port_allowed_address_pair_v2.pair_1", "mac_address", "fa:16:3e:00:00:01"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ecl_network_port_allowed_address_pair_v2.pair_1",
+				ImportState:       true,
+				ImportStateId:     "6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01/192.168.1.100",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testMockNetworkV2PortAllowedAddressPairBasic = `
+resource "ecl_network_port_allowed_address_pair_v2" "pair_1" {
+  port_id = "6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01"
+  ip_address = "192.168.1.100"
+}
+`
+
+var testMockNetworkV2PortAllowedAddressPairGet = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "port": {
+            "admin_state_up": true,
+            "allowed_address_pairs": [],
+            "description": "",
+            "device_id": "",
+            "device_owner": "",
+            "fixed_ips": [
+              {
+                "ip_address": "192.168.1.2",
+                "subnet_id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+              }
+            ],
+            "id": "6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01",
+            "mac_address": "fa:16:3e:00:00:01",
+            "name": "port_1",
+            "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+            "segmentation_id": 0,
+            "segmentation_type": "flat",
+            "status": "ACTIVE",
+            "tags": {},
+            "tenant_id": "%s"
+          }
+        }
+`, OS_TENANT_ID)
+
+var testMockNetworkV2PortAllowedAddressPairAdd = fmt.Sprintf(`
+request:
+    method: PUT
+    body: >
+        {"port":{"allowed_address_pairs":[{"ip_address":"192.168.1.100","mac_address":"fa:16:3e:00:00:01"}]}}
+response:
+    code: 200
+    body: >
+        {
+          "port": {
+            "admin_state_up": true,
+            "allowed_address_pairs": [
+              {
+                "ip_address": "192.168.1.100",
+                "mac_address": "fa:16:3e:00:00:01"
+              }
+            ],
+            "description": "",
+            "device_id": "",
+            "device_owner": "",
+            "fixed_ips": [
+              {
+                "ip_address": "192.168.1.2",
+                "subnet_id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+              }
+            ],
+            "id": "6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01",
+            "mac_address": "fa:16:3e:00:00:01",
+            "name": "port_1",
+            "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+            "segmentation_id": 0,
+            "segmentation_type": "flat",
+            "status": "ACTIVE",
+            "tags": {},
+            "tenant_id": "%s"
+          }
+        }
+newStatus: Created
+`, OS_TENANT_ID)
+
+var testMockNetworkV2PortAllowedAddressPairGetAfterAdd = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "port": {
+            "admin_state_up": true,
+            "allowed_address_pairs": [
+              {
+                "ip_address": "192.168.1.100",
+                "mac_address": "fa:16:3e:00:00:01"
+              }
+            ],
+            "description": "",
+            "device_id": "",
+            "device_owner": "",
+            "fixed_ips": [
+              {
+                "ip_address": "192.168.1.2",
+                "subnet_id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+              }
+            ],
+            "id": "6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01",
+            "mac_address": "fa:16:3e:00:00:01",
+            "name": "port_1",
+            "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+            "segmentation_id": 0,
+            "segmentation_type": "flat",
+            "status": "ACTIVE",
+            "tags": {},
+            "tenant_id": "%s"
+          }
+        }
+expectedStatus:
+    - Created
+`, OS_TENANT_ID)
+
+var testMockNetworkV2PortAllowedAddressPairRemove = fmt.Sprintf(`
+request:
+    method: PUT
+    body: >
+        {"port":{"allowed_address_pairs":[]}}
+response:
+    code: 200
+    body: >
+        {
+          "port": {
+            "admin_state_up": true,
+            "allowed_address_pairs": [],
+            "description": "",
+            "device_id": "",
+            "device_owner": "",
+            "fixed_ips": [
+              {
+                "ip_address": "192.168.1.2",
+                "subnet_id": "9b2c3d4e-2222-4f6a-9b0c-1d2e3f4a5b01"
+              }
+            ],
+            "id": "6c3d4e5f-3333-4a7b-8c9d-0e1f2a3b4c01",
+            "mac_address": "fa:16:3e:00:00:01",
+            "name": "port_1",
+            "network_id": "8a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a01",
+            "segmentation_id": 0,
+            "segmentation_type": "flat",
+            "status": "ACTIVE",
+            "tags": {},
+            "tenant_id": "%s"
+          }
+        }
+expectedStatus:
+    - Created
+newStatus: Deleted
+`, OS_TENANT_ID)
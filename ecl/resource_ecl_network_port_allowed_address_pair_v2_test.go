@@ -0,0 +1,182 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/ports"
+)
+
+func TestAccNetworkV2PortAllowedAddressPair_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip this test in short mode")
+	}
+
+	var instancePort ports.Port
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2PortAllowedAddressPairDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkV2PortAllowedAddressPairBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkV2PortExists("ecl_network_port_v2.instance_port", &instancePort),
+					testAccCheckNetworkV2PortCountAllowedAddressPairs(&instancePort, 2),
+					resource.TestCheckResourceAttr(
+						"ecl_network_port_allowed_address_pair_v2.pair_1", "ip_address", "10.0.0.202"),
+					resource.TestCheckResourceAttrPair(
+						"ecl_network_port_allowed_address_pair_v2.pair_1", "mac_address",
+						"ecl_network_port_v2.vrrp_port1", "mac_address"),
+					resource.TestCheckResourceAttrPair(
+						"ecl_network_port_allowed_address_pair_v2.pair_2", "mac_address",
+						"ecl_network_port_v2.instance_port", "mac_address"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ecl_network_port_allowed_address_pair_v2.pair_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccNetworkV2PortAllowedAddressPairRemove,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkV2PortExists("ecl_network_port_v2.instance_port", &instancePort),
+					testAccCheckNetworkV2PortCountAllowedAddressPairs(&instancePort, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestNetworkPortAllowedAddressPairV2ParseID(t *testing.T) {
+	cases := []struct {
+		id         string
+		ipAddress  string
+		macAddress string
+		valid      bool
+	}{
+		{"port/10.0.0.202/fa:16:3e:00:00:01", "10.0.0.202", "fa:16:3e:00:00:01", true},
+		{"port/10.0.0.0/24/fa:16:3e:00:00:01", "10.0.0.0/24", "fa:16:3e:00:00:01", true},
+		{"port/10.0.0.202", "10.0.0.202", "", true},
+		{"port/10.0.0.0/24", "10.0.0.0/24", "", true},
+		{"port/2001:db8::1", "2001:db8::1", "", true},
+		{"port", "", "", false},
+		{"/10.0.0.202", "", "", false},
+	}
+
+	for _, c := range cases {
+		portID, ipAddress, macAddress, err := networkPortAllowedAddressPairV2ParseID(c.id)
+		if !c.valid {
+			if err == nil {
+				t.Fatalf("%s: expected an error", c.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.id, err)
+		}
+		if portID != "port" || ipAddress != c.ipAddress || macAddress != c.macAddress {
+			t.Fatalf("%s: got %s, %s, %s", c.id, portID, ipAddress, macAddress)
+		}
+	}
+}
+
+func testAccCheckNetworkV2PortAllowedAddressPairDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkClient, err := config.networkV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ecl_network_port_allowed_address_pair_v2" {
+			continue
+		}
+
+		portID, ipAddress, macAddress, err := networkPortAllowedAddressPairV2ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		port, err := ports.Get(networkClient, portID).Extract()
+		if err != nil {
+			continue
+		}
+
+		if networkPortAllowedAddressPairV2Find(port, ipAddress, macAddress) >= 0 {
+			return fmt.Errorf("Allowed address pair %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkV2PortAllowedAddressPairPorts = `
+resource "ecl_network_network_v2" "vrrp_network" {
+  name = "vrrp_network"
+  admin_state_up = "true"
+}
+
+resource "ecl_network_subnet_v2" "vrrp_subnet" {
+  name = "vrrp_subnet"
+  cidr = "10.0.0.0/24"
+  ip_version = 4
+  network_id = "${ecl_network_network_v2.vrrp_network.id}"
+
+  allocation_pools {
+    start = "10.0.0.2"
+    end = "10.0.0.200"
+  }
+}
+
+resource "ecl_network_port_v2" "vrrp_port1" {
+  name = "vrrp_port1"
+  admin_state_up = "true"
+  network_id = "${ecl_network_network_v2.vrrp_network.id}"
+
+  fixed_ip {
+    subnet_id =  "${ecl_network_subnet_v2.vrrp_subnet.id}"
+    ip_address = "10.0.0.202"
+  }
+}
+
+resource "ecl_network_port_v2" "instance_port" {
+  name = "instance_port"
+  admin_state_up = "true"
+  network_id = "${ecl_network_network_v2.vrrp_network.id}"
+
+  lifecycle {
+    ignore_changes = ["allowed_address_pairs"]
+  }
+}
+`
+
+var testAccNetworkV2PortAllowedAddressPairBasic = fmt.Sprintf(`
+%s
+
+resource "ecl_network_port_allowed_address_pair_v2" "pair_1" {
+  port_id = "${ecl_network_port_v2.instance_port.id}"
+  ip_address = "${ecl_network_port_v2.vrrp_port1.fixed_ip.0.ip_address}"
+  mac_address = "${ecl_network_port_v2.vrrp_port1.mac_address}"
+}
+
+resource "ecl_network_port_allowed_address_pair_v2" "pair_2" {
+  port_id = "${ecl_network_port_v2.instance_port.id}"
+  ip_address = "10.0.0.210"
+}
+`, testAccNetworkV2PortAllowedAddressPairPorts)
+
+var testAccNetworkV2PortAllowedAddressPairRemove = fmt.Sprintf(`
+%s
+
+resource "ecl_network_port_allowed_address_pair_v2" "pair_1" {
+  port_id = "${ecl_network_port_v2.instance_port.id}"
+  ip_address = "${ecl_network_port_v2.vrrp_port1.fixed_ip.0.ip_address}"
+  mac_address = "${ecl_network_port_v2.vrrp_port1.mac_address}"
+}
+`, testAccNetworkV2PortAllowedAddressPairPorts)
@@ -0,0 +1,273 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/nttcom/eclcloud/v3"
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/security_group_rules"
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/security_groups"
+)
+
+func resourceNetworkSecurityGroupRulesV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkSecurityGroupRulesV2Create,
+		Read:   resourceNetworkSecurityGroupRulesV2Read,
+		Update: resourceNetworkSecurityGroupRulesV2Update,
+		Delete: resourceNetworkSecurityGroupRulesV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      networkSecurityGroupRuleV2Hash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"direction": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ingress", "egress",
+							}, false),
+						},
+						"ethertype": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "IPv4",
+							ValidateFunc: validation.StringInSlice([]string{
+								"IPv4", "IPv6",
+							}, false),
+						},
+						"protocol": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							StateFunc: func(v interface{}) string {
+								return networkSecurityGroupRuleV2NormalizeProtocol(v)
+							},
+						},
+						"port_range_min": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"port_range_max": &schema.Schema{
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateFunc:     validation.IntBetween(0, 65535),
+							DiffSuppressFunc: networkSecurityGroupRuleV2PortRangeMaxDiffSuppress,
+						},
+						"remote_ip_prefix": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							StateFunc: func(v interface{}) string {
+								return networkSecurityGroupRuleV2NormalizeRemoteIPPrefix(v)
+							},
+						},
+						"remote_group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkSecurityGroupRulesV2Create(d *schema.ResourceData, meta interface{}) error {
+	sgID := d.Get("security_group_id").(string)
+
+	rules := d.Get("rule").(*schema.Set)
+	if err := resourceNetworkSecurityGroupRulesV2Apply(d, meta, sgID, rules, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(sgID)
+
+	return resourceNetworkSecurityGroupRulesV2Read(d, meta)
+}
+
+func resourceNetworkSecurityGroupRulesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	rules, err := networkSecurityGroupRulesV2List(networkClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving ECL Security Group Rules of %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved Security Group Rules of %s: %+v", d.Id(), rules)
+
+	if len(rules) == 0 {
+		if _, err := security_groups.Get(networkClient, d.Id()).Extract(); err != nil {
+			return CheckDeleted(d, err, "Security Group")
+		}
+	}
+
+	ruleSet := schema.NewSet(networkSecurityGroupRuleV2Hash, nil)
+	for _, rule := range rules {
+		ruleSet.Add(flattenNetworkSecurityGroupRuleV2(rule))
+	}
+
+	d.Set("security_group_id", d.Id())
+	if err := d.Set("rule", ruleSet); err != nil {
+		return fmt.Errorf("Error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkSecurityGroupRulesV2Update(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("rule") {
+		rules := d.Get("rule").(*schema.Set)
+		if err := resourceNetworkSecurityGroupRulesV2Apply(d, meta, d.Id(), rules, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceNetworkSecurityGroupRulesV2Read(d, meta)
+}
+
+// resourceNetworkSecurityGroupRulesV2Delete removes every rule of the
+// security group, including the ones created outside of Terraform.
+func resourceNetworkSecurityGroupRulesV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	if _, err := security_groups.Get(networkClient, d.Id()).Extract(); err != nil {
+		return CheckDeleted(d, err, "Security Group")
+	}
+
+	rules := schema.NewSet(networkSecurityGroupRuleV2Hash, nil)
+	if err := resourceNetworkSecurityGroupRulesV2Apply(d, meta, d.Id(), rules, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceNetworkSecurityGroupRulesV2Apply makes the rules of the security
+// group match the rule set. Only the rules missing from the group are
+// created, and only the rules missing from the set are deleted.
+func resourceNetworkSecurityGroupRulesV2Apply(d *schema.ResourceData, meta interface{}, sgID string, ruleSet *schema.Set, timeout time.Duration) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	// Lock the security group to serialize operations on it
+	osMutexKV.Lock(sgID)
+	defer osMutexKV.Unlock(sgID)
+
+	if err := networkSecurityGroupRulesV2WaitForActive(networkClient, sgID, timeout); err != nil {
+		return err
+	}
+
+	rules, err := networkSecurityGroupRulesV2List(networkClient, sgID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ECL Security Group Rules of %s: %s", sgID, err)
+	}
+
+	desired := make(map[string]bool)
+	for _, v := range ruleSet.List() {
+		desired[networkSecurityGroupRuleV2Key(v.(map[string]interface{}))] = true
+	}
+
+	existing := make(map[string]bool)
+	var deleteIDs []string
+	for _, rule := range rules {
+		key := networkSecurityGroupRuleV2Key(flattenNetworkSecurityGroupRuleV2(rule))
+		if desired[key] && !existing[key] {
+			existing[key] = true
+			continue
+		}
+		deleteIDs = append(deleteIDs, rule.ID)
+	}
+
+	for _, id := range deleteIDs {
+		log.Printf("[DEBUG] Deleting Security Group Rule %s of %s", id, sgID)
+		if err := security_group_rules.Delete(networkClient, id).ExtractErr(); err != nil {
+			if _, ok := err.(eclcloud.ErrDefault404); !ok {
+				return fmt.Errorf("Error deleting ECL Security Group Rule %s: %s", id, err)
+			}
+		}
+	}
+
+	for _, v := range ruleSet.List() {
+		m := v.(map[string]interface{})
+		if existing[networkSecurityGroupRuleV2Key(m)] {
+			continue
+		}
+
+		createOpts := expandNetworkSecurityGroupRuleV2CreateOpts(m, sgID)
+		log.Printf("[DEBUG] Creating Security Group Rule: %#v", createOpts)
+		if _, err := security_group_rules.Create(networkClient, createOpts).Extract(); err != nil {
+			return fmt.Errorf("Error creating ECL Security Group Rule: %s", err)
+		}
+	}
+
+	return networkSecurityGroupRulesV2WaitForActive(networkClient, sgID, timeout)
+}
+
+func networkSecurityGroupRulesV2WaitForActive(networkClient *eclcloud.ServiceClient, sgID string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for Security Group (%s) to become available", sgID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING_CREATE", "PENDING_UPDATE", "PENDING_DELETE"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    waitForSecurityGroupActiveAfterRuleChange(networkClient, sgID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Security Group (%s) to become ready: %s", sgID, err)
+	}
+
+	return nil
+}
+
+func networkSecurityGroupRulesV2List(networkClient *eclcloud.ServiceClient, sgID string) ([]security_group_rules.SecurityGroupRule, error) {
+	listOpts := security_group_rules.ListOpts{
+		SecurityGroupID: sgID,
+	}
+
+	allPages, err := security_group_rules.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	return security_group_rules.ExtractSecurityGroupRules(allPages)
+}
@@ -0,0 +1,349 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/nttcom/terraform-provider-ecl/ecl/testhelper/mock"
+)
+
+func TestMockedNetworkV2SecurityGroupRules_basic(t *testing.T) {
+	mc := mock.NewMockController()
+	defer mc.TerminateMockControllerSafety()
+
+	postKeystone := fmt.Sprintf(fakeKeystonePostTmpl, mc.Endpoint(), OS_REGION_NAME)
+	mc.Register(t, "keystone", "/v3/auth/tokens", postKeystone)
+	mc.Register(t, "security_groups", "/v2.0/security-groups/3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f", testMockNetworkV2SecurityGroupRulesGetSecurityGroup)
+	mc.Register(t, "security_group_rules", "/v2.0/security-group-rules", testMockNetworkV2SecurityGroupRulesListAfterCreate)
+	mc.Register(t, "security_group_rules", "/v2.0/security-group-rules", testMockNetworkV2SecurityGroupRulesListAfterUpdate)
+	mc.Register(t, "security_group_rules", "/v2.0/security-group-rules", testMockNetworkV2SecurityGroupRulesListAfterDelete)
+	mc.Register(t, "security_group_rules", "/v2.0/security-group-rules", testMockNetworkV2SecurityGroupRulesListDefault)
+	mc.Register(t, "security_group_rules", "/v2.0/security-group-rules", testMockNetworkV2SecurityGroupRulesPostTCP22)
+	mc.Register(t, "security_group_rules", "/v2.0/security-group-rules", testMockNetworkV2SecurityGroupRulesPostTCP443)
+	mc.Register(t, "security_group_rules", "/v2.0/security-group-rules/a1b2c3d4-0000-4000-8000-000000000002", testMockNetworkV2SecurityGroupRulesDeleteEgressIPv6)
+	mc.Register(t, "security_group_rules", "/v2.0/security-group-rules/a1b2c3d4-0000-4000-8000-000000000003", testMockNetworkV2SecurityGroupRulesDeleteTCP22)
+	mc.Register(t, "security_group_rules", "/v2.0/security-group-rules/a1b2c3d4-0000-4000-8000-000000000001", testMockNetworkV2SecurityGroupRulesDeleteEgressIPv4)
+	mc.Register(t, "security_group_rules", "/v2.0/security-group-rules/a1b2c3d4-0000-4000-8000-000000000004", testMockNetworkV2SecurityGroupRulesDeleteTCP443)
+	mc.StartServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2SecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testMockNetworkV2SecurityGroupRulesBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ecl_network_security_group_rules_v2.rules_1", "id", "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"),
+					resource.TestCheckResourceAttr("ecl_network_security_group_rules_v2.rules_1", "rule.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testMockNetworkV2SecurityGroupRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ecl_network_security_group_rules_v2.rules_1", "rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+var testMockNetworkV2SecurityGroupRulesBasic = `
+resource "ecl_network_security_group_rules_v2" "rules_1" {
+  security_group_id = "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+
+  rule {
+    direction = "egress"
+    protocol = "any"
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction = "ingress"
+    protocol = "6"
+    port_range_min = 22
+    port_range_max = 22
+    remote_ip_prefix = "10.1.2.3/8"
+  }
+}
+`
+
+var testMockNetworkV2SecurityGroupRulesUpdate = `
+resource "ecl_network_security_group_rules_v2" "rules_1" {
+  security_group_id = "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+
+  rule {
+    direction = "egress"
+  }
+
+  rule {
+    direction = "ingress"
+    protocol = "tcp"
+    port_range_min = 443
+    port_range_max = 443
+    remote_ip_prefix = "10.0.0.0/8"
+  }
+}
+`
+
+var testMockNetworkV2SecurityGroupRulesGetSecurityGroup = fmt.Sprintf(`
+request:
+    method: GET
+response:
+    code: 200
+    body: >
+        {
+          "security_group": {
+            "description": "",
+            "id": "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+            "name": "secgroup_1",
+            "security_group_rules": [],
+            "status": "ACTIVE",
+            "tags": {},
+            "tenant_id": "%s"
+          }
+        }
+`, OS_TENANT_ID)
+
+var testMockNetworkV2SecurityGroupRulesListDefault = fmt.Sprintf(`
+request:
+    method: GET
+    query:
+        security_group_id:
+            - 3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f
+response:
+    code: 200
+    body: >
+        {
+          "security_group_rules": [
+            {
+              "description": "",
+              "direction": "egress",
+              "ethertype": "IPv4",
+              "id": "a1b2c3d4-0000-4000-8000-000000000001",
+              "port_range_max": 65535,
+              "port_range_min": 0,
+              "protocol": "any",
+              "remote_group_id": null,
+              "remote_ip_prefix": "0.0.0.0/0",
+              "security_group_id": "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+              "tenant_id": "%s"
+            },
+            {
+              "description": "",
+              "direction": "egress",
+              "ethertype": "IPv6",
+              "id": "a1b2c3d4-0000-4000-8000-000000000002",
+              "port_range_max": 65535,
+              "port_range_min": 0,
+              "protocol": "any",
+              "remote_group_id": null,
+              "remote_ip_prefix": "::/0",
+              "security_group_id": "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+              "tenant_id": "%s"
+            }
+          ]
+        }
+`, OS_TENANT_ID, OS_TENANT_ID)
+
+var testMockNetworkV2SecurityGroupRulesDeleteEgressIPv6 = `
+request:
+    method: DELETE
+response:
+    code: 204
+expectedStatus:
+    - ""
+newStatus: DeletedEgressIPv6
+`
+
+var testMockNetworkV2SecurityGroupRulesPostTCP22 = fmt.Sprintf(`
+request:
+    method: POST
+    body: >
+        {"security_group_rule":{"direction":"ingress","ethertype":"IPv4","port_range_max":22,"port_range_min":22,"protocol":"tcp","remote_ip_prefix":"10.0.0.0/8","security_group_id":"3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"}}
+response:
+    code: 201
+    body: >
+        {
+          "security_group_rule":
+          {
+            "description": "",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "id": "a1b2c3d4-0000-4000-8000-000000000003",
+            "port_range_max": 22,
+            "port_range_min": 22,
+            "protocol": "tcp",
+            "remote_group_id": null,
+            "remote_ip_prefix": "10.0.0.0/8",
+            "security_group_id": "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+            "tenant_id": "%s"
+          }
+        }
+expectedStatus:
+    - DeletedEgressIPv6
+newStatus: Created
+`, OS_TENANT_ID)
+
+var testMockNetworkV2SecurityGroupRulesListAfterCreate = fmt.Sprintf(`
+request:
+    method: GET
+    query:
+        security_group_id:
+            - 3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f
+response:
+    code: 200
+    body: >
+        {
+          "security_group_rules": [
+            {
+              "description": "",
+              "direction": "egress",
+              "ethertype": "IPv4",
+              "id": "a1b2c3d4-0000-4000-8000-000000000001",
+              "port_range_max": 65535,
+              "port_range_min": 0,
+              "protocol": "any",
+              "remote_group_id": null,
+              "remote_ip_prefix": "0.0.0.0/0",
+              "security_group_id": "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+              "tenant_id": "%s"
+            },
+            {
+              "description": "",
+              "direction": "ingress",
+              "ethertype": "IPv4",
+              "id": "a1b2c3d4-0000-4000-8000-000000000003",
+              "port_range_max": 22,
+              "port_range_min": 22,
+              "protocol": "tcp",
+              "remote_group_id": null,
+              "remote_ip_prefix": "10.0.0.0/8",
+              "security_group_id": "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+              "tenant_id": "%s"
+            }
+          ]
+        }
+expectedStatus:
+    - Created
+`, OS_TENANT_ID, OS_TENANT_ID)
+
+var testMockNetworkV2SecurityGroupRulesDeleteTCP22 = `
+request:
+    method: DELETE
+response:
+    code: 204
+expectedStatus:
+    - Created
+newStatus: DeletedTCP22
+`
+
+var testMockNetworkV2SecurityGroupRulesPostTCP443 = fmt.Sprintf(`
+request:
+    method: POST
+    body: >
+        {"security_group_rule":{"direction":"ingress","ethertype":"IPv4","port_range_max":443,"port_range_min":443,"protocol":"tcp","remote_ip_prefix":"10.0.0.0/8","security_group_id":"3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"}}
+response:
+    code: 201
+    body: >
+        {
+          "security_group_rule":
+          {
+            "description": "",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "id": "a1b2c3d4-0000-4000-8000-000000000004",
+            "port_range_max": 443,
+            "port_range_min": 443,
+            "protocol": "tcp",
+            "remote_group_id": null,
+            "remote_ip_prefix": "10.0.0.0/8",
+            "security_group_id": "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+            "tenant_id": "%s"
+          }
+        }
+expectedStatus:
+    - DeletedTCP22
+newStatus: Updated
+`, OS_TENANT_ID)
+
+var testMockNetworkV2SecurityGroupRulesListAfterUpdate = fmt.Sprintf(`
+request:
+    method: GET
+    query:
+        security_group_id:
+            - 3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f
+response:
+    code: 200
+    body: >
+        {
+          "security_group_rules": [
+            {
+              "description": "",
+              "direction": "egress",
+              "ethertype": "IPv4",
+              "id": "a1b2c3d4-0000-4000-8000-000000000001",
+              "port_range_max": 65535,
+              "port_range_min": 0,
+              "protocol": "any",
+              "remote_group_id": null,
+              "remote_ip_prefix": "0.0.0.0/0",
+              "security_group_id": "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+              "tenant_id": "%s"
+            },
+            {
+              "description": "",
+              "direction": "ingress",
+              "ethertype": "IPv4",
+              "id": "a1b2c3d4-0000-4000-8000-000000000004",
+              "port_range_max": 443,
+              "port_range_min": 443,
+              "protocol": "tcp",
+              "remote_group_id": null,
+              "remote_ip_prefix": "10.0.0.0/8",
+              "security_group_id": "3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f",
+              "tenant_id": "%s"
+            }
+          ]
+        }
+expectedStatus:
+    - Updated
+`, OS_TENANT_ID, OS_TENANT_ID)
+
+var testMockNetworkV2SecurityGroupRulesDeleteEgressIPv4 = `
+request:
+    method: DELETE
+response:
+    code: 204
+expectedStatus:
+    - Updated
+newStatus: DeletedEgressIPv4
+`
+
+var testMockNetworkV2SecurityGroupRulesDeleteTCP443 = `
+request:
+    method: DELETE
+response:
+    code: 204
+expectedStatus:
+    - DeletedEgressIPv4
+newStatus: Deleted
+`
+
+var testMockNetworkV2SecurityGroupRulesListAfterDelete = `
+request:
+    method: GET
+    query:
+        security_group_id:
+            - 3c2d1e0f-4a5b-4c6d-8e7f-9a0b1c2d3e4f
+response:
+    code: 200
+    body: >
+        {
+          "security_group_rules": []
+        }
+expectedStatus:
+    - Deleted
+`
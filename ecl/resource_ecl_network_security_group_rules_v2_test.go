@@ -0,0 +1,117 @@
+package ecl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkV2SecurityGroupRules_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkV2SecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkV2SecurityGroupRulesBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ecl_network_security_group_rules_v2.rules_1", "id",
+						"ecl_network_security_group_v2.secgroup_1", "id"),
+					resource.TestCheckResourceAttr("ecl_network_security_group_rules_v2.rules_1", "rule.#", "3"),
+				),
+			},
+			{
+				Config: testAccNetworkV2SecurityGroupRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ecl_network_security_group_rules_v2.rules_1", "rule.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ecl_network_security_group_rules_v2.rules_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkV2SecurityGroupRulesDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkClient, err := config.networkV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ecl_network_security_group_rules_v2" {
+			continue
+		}
+
+		rules, err := networkSecurityGroupRulesV2List(networkClient, rs.Primary.ID)
+		if err != nil {
+			continue
+		}
+
+		if len(rules) > 0 {
+			return fmt.Errorf("Security group %s still has %d rules", rs.Primary.ID, len(rules))
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkV2SecurityGroupRulesBasic = `
+resource "ecl_network_security_group_v2" "secgroup_1" {
+  name = "secgroup_1"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "ecl_network_security_group_rules_v2" "rules_1" {
+  security_group_id = "${ecl_network_security_group_v2.secgroup_1.id}"
+
+  rule {
+    direction = "egress"
+    ethertype = "IPv4"
+  }
+
+  rule {
+    direction = "ingress"
+    protocol = "tcp"
+    port_range_min = 22
+    port_range_max = 22
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction = "ingress"
+    protocol = "icmp"
+    remote_ip_prefix = "192.168.0.1/24"
+  }
+}
+`
+
+const testAccNetworkV2SecurityGroupRulesUpdate = `
+resource "ecl_network_security_group_v2" "secgroup_1" {
+  name = "secgroup_1"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "ecl_network_security_group_rules_v2" "rules_1" {
+  security_group_id = "${ecl_network_security_group_v2.secgroup_1.id}"
+
+  rule {
+    direction = "egress"
+    ethertype = "IPv4"
+  }
+
+  rule {
+    direction = "ingress"
+    protocol = "6"
+    port_range_min = 443
+    port_range_max = 443
+  }
+}
+`
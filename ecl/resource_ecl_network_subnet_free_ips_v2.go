@@ -0,0 +1,105 @@
+package ecl
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/nttcom/eclcloud/v3/ecl/network/v2/subnets"
+)
+
+// resourceNetworkSubnetFreeIPsV2 allocates unused addresses of a subnet once
+// and keeps them in the state, unlike ecl_network_subnet_free_ips_v2 data
+// source which skips them on the next refresh after they are used.
+func resourceNetworkSubnetFreeIPsV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkSubnetFreeIPsV2Create,
+		Read:   resourceNetworkSubnetFreeIPsV2Read,
+		Delete: resourceNetworkSubnetFreeIPsV2Delete,
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_count": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"exclude": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed values
+			"ip_addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetworkSubnetFreeIPsV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	subnetID := d.Get("subnet_id").(string)
+	subnet, err := subnets.Get(networkClient, subnetID).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve subnet %s: %s", subnetID, err)
+	}
+
+	var excluded []string
+	for _, v := range d.Get("exclude").(*schema.Set).List() {
+		excluded = append(excluded, v.(string))
+	}
+
+	ipAddresses, err := networkSubnetV2AllocateIPs(networkClient, subnet, excluded, d.Get("ip_count").(int))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Allocated free IP addresses of subnet %s: %v", subnetID, ipAddresses)
+
+	d.SetId(fmt.Sprintf("%s-%d", subnetID, hashcode.String(strings.Join(ipAddresses, ","))))
+	d.Set("ip_addresses", ipAddresses)
+
+	return resourceNetworkSubnetFreeIPsV2Read(d, meta)
+}
+
+// resourceNetworkSubnetFreeIPsV2Read only checks that the subnet still
+// exists. The allocated addresses are kept as they are, even once they are
+// used by ports.
+func resourceNetworkSubnetFreeIPsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkClient, err := config.networkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating ECL network client: %s", err)
+	}
+
+	subnetID := d.Get("subnet_id").(string)
+	if _, err := subnets.Get(networkClient, subnetID).Extract(); err != nil {
+		return CheckDeleted(d, err, "subnet")
+	}
+
+	return nil
+}
+
+func resourceNetworkSubnetFreeIPsV2Delete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
@@ -55,6 +55,18 @@ func GetRegion(d *schema.ResourceData, config *Config) string {
 	return config.Region
 }
 
+// GetRegionFromDiff is the same as GetRegion for a resource diff. It returns
+// the default region while the region is not known yet.
+func GetRegionFromDiff(d *schema.ResourceDiff, config *Config) string {
+	if d.NewValueKnown("region") {
+		if v, ok := d.GetOk("region"); ok {
+			return v.(string)
+		}
+	}
+
+	return config.Region
+}
+
 // AddValueSpecs expands the 'value_specs' object and removes 'value_specs'
 // from the request body.
 func AddValueSpecs(body map[string]interface{}) map[string]interface{} {
@@ -254,3 +266,14 @@ func ternary(condition bool, ifOutput interface{}, elseOutput interface{}) inter
 
 	return elseOutput
 }
+
+// networkV2TagsMatch returns whether the tags contain every key and value of
+// the filter.
+func networkV2TagsMatch(tags map[string]string, filter map[string]string) bool {
+	for k, v := range filter {
+		if tv, ok := tags[k]; !ok || tv != v {
+			return false
+		}
+	}
+	return true
+}
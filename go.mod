@@ -3,6 +3,7 @@ module github.com/nttcom/terraform-provider-ecl
 require (
 	github.com/hashicorp/terraform v0.12.6
 	github.com/nttcom/eclcloud/v3 v3.5.0
+	github.com/ulikunitz/xz v0.5.11
 	github.com/unknwon/com v0.0.0-20190804042917-757f69c95f3e
 	gopkg.in/yaml.v2 v2.2.8
 )
@@ -60,7 +61,6 @@ require (
 	github.com/posener/complete v1.2.1 // indirect
 	github.com/satori/go.uuid v1.2.0 // indirect
 	github.com/spf13/afero v1.2.1 // indirect
-	github.com/ulikunitz/xz v0.5.11 // indirect
 	github.com/vmihailenco/msgpack v4.0.1+incompatible // indirect
 	github.com/zclconf/go-cty v1.0.1-0.20190708163926-19588f92a98f // indirect
 	github.com/zclconf/go-cty-yaml v1.0.1 // indirect